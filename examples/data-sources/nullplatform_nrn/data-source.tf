@@ -0,0 +1,19 @@
+# Resolve slugs, such as a module input, to the NRN and numeric IDs.
+data "nullplatform_nrn" "checkout" {
+  path = "acme/payments/checkout"
+}
+
+resource "nullplatform_scope" "prod" {
+  null_application_id = data.nullplatform_nrn.checkout.application_id
+  scope_name          = "prod"
+  # ...
+}
+
+# Or go the other way, from an NRN to the slugs and names of every level.
+data "nullplatform_nrn" "from_nrn" {
+  nrn = "organization=1255165411:account=95118862:namespace=463208973"
+}
+
+output "namespace_name" {
+  value = data.nullplatform_nrn.from_nrn.namespace_name
+}
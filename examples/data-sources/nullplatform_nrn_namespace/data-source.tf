@@ -0,0 +1,9 @@
+data "nullplatform_nrn_namespace" "k8s" {
+  nrn       = "organization=1255165411:account=95118862"
+  namespace = "k8s"
+  keys      = ["cluster_name", "namespace"]
+}
+
+output "cluster_name" {
+  value = data.nullplatform_nrn_namespace.k8s.values["cluster_name"]
+}
@@ -43,6 +43,22 @@ resource "nullplatform_link" "link_redis" {
   attributes = {}
 }
 
+resource "nullplatform_link" "link_redis_provisioned" {
+  name             = "link_from_terraform_provisioned"
+  service_id       = data.nullplatform_service.redis.id
+  specification_id = "66919464-05e6-4d78-bb8c-902c57881ddd"
+  entity_nrn       = data.nullplatform_application.app.nrn
+  import           = false
+
+  timeouts {
+    create = "10m"
+    update = "10m"
+    delete = "10m"
+  }
+
+  attributes = {}
+}
+
 output "link" {
   value = nullplatform_link.link_redis
 }
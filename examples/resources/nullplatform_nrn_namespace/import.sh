@@ -0,0 +1,3 @@
+# An NRN namespace is imported by its NRN, namespace and the keys to manage,
+# which must be listed because the API cannot list a namespace.
+terraform import nullplatform_nrn_namespace.k8s "organization=1255165411:account=95118862|k8s|cluster_name,namespace"
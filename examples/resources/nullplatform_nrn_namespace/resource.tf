@@ -0,0 +1,11 @@
+# Manage the Kubernetes settings of an account. Other k8s keys set on the
+# account NRN are left alone.
+resource "nullplatform_nrn_namespace" "k8s" {
+  nrn       = "organization=1255165411:account=95118862"
+  namespace = "k8s"
+
+  values = {
+    cluster_name = "main"
+    namespace    = "payments"
+  }
+}
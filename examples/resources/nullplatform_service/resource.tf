@@ -66,7 +66,7 @@ output "open_weather" {
   value = nullplatform_service.open_weather_test
 }
 
-# Action-driven mode: provider triggers the spec's create+delete actions.
+# Action-driven mode: provider triggers the spec's create, update and delete actions.
 resource "nullplatform_service" "open_weather_provisioned" {
   name             = "open-weather-provisioned"
   specification_id = var.provisioned_specification_id
@@ -77,6 +77,7 @@ resource "nullplatform_service" "open_weather_provisioned" {
 
   timeouts {
     create = "10m"
+    update = "10m"
     delete = "10m"
   }
 
@@ -90,3 +91,7 @@ variable "provisioned_specification_id" {
   description = "Specification ID for a service whose create+delete actions should be triggered."
   type        = string
 }
+
+output "open_weather_endpoint" {
+  value = nullplatform_service.open_weather_provisioned.results["endpoint"]
+}
@@ -18,6 +18,11 @@ variable "action_specification_id" {
   type        = string
 }
 
+variable "redis_version" {
+  description = "Version of redis the service runs"
+  type        = string
+}
+
 # Triggers an action defined by an action specification against an existing
 # service. Any change to the inputs re-triggers the action (ForceNew).
 resource "nullplatform_service_action" "resize_redis" {
@@ -27,6 +32,20 @@ resource "nullplatform_service_action" "resize_redis" {
   parameters = jsonencode({
     size = "large"
   })
+
+  # Runs the resize again whenever the redis version changes.
+  triggers = {
+    redis_version = var.redis_version
+  }
+
+  max_retries = 1
+
+  # After an interrupted apply, take over the resize left running.
+  on_action_in_progress = "adopt"
+
+  timeouts {
+    create = "30m"
+  }
 }
 
 output "action_status" {
@@ -36,3 +55,7 @@ output "action_status" {
 output "action_results" {
   value = nullplatform_service_action.resize_redis.results
 }
+
+output "action_port" {
+  value = tonumber(nullplatform_service_action.resize_redis.results["port"])
+}
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const ACCOUNT_PATH = "/account"
@@ -21,39 +21,35 @@ type Account struct {
 	Settings           map[string]interface{} `json:"settings,omitempty"`
 }
 
-func (c *NullClient) CreateAccount(account *Account) (*Account, error) {
+func (c *NullClient) CreateAccount(ctx context.Context, account *Account) (created *Account, adoptedAfter error, err error) {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(*account)
+	err = json.NewEncoder(&buf).Encode(*account)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.MakeRequest("POST", ACCOUNT_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", ACCOUNT_PATH, &buf)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
+		if isAmbiguousRequestError(err) {
+			return c.reconcileAccountCreate(ctx, account, err)
 		}
-		return nil, fmt.Errorf("error creating account resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+		return nil, nil, err
 	}
+	defer res.Body.Close()
 
 	accountRes := &Account{}
-	derr := json.NewDecoder(res.Body).Decode(accountRes)
-
-	if derr != nil {
-		return nil, derr
+	if err := decodeJSON(res, "account", "create", accountRes); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileAccountCreate(ctx, account, err)
+		}
+		return nil, nil, err
 	}
 
-	return accountRes, nil
+	return accountRes, nil, nil
 }
 
-func (c *NullClient) PatchAccount(accountId string, account *Account) error {
+func (c *NullClient) PatchAccount(ctx context.Context, accountId string, account *Account) error {
 	path := fmt.Sprintf("%s/%s", ACCOUNT_PATH, accountId)
 
 	var buf bytes.Buffer
@@ -63,70 +59,48 @@ func (c *NullClient) PatchAccount(accountId string, account *Account) error {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating account resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "account", "update")
 }
 
-func (c *NullClient) GetAccount(accountId string) (*Account, error) {
+func (c *NullClient) GetAccount(ctx context.Context, accountId string) (*Account, error) {
 	// Always request soft-deleted rows so Terraform can reconcile state when an
 	// account has been deleted out-of-band. The API responds 200 with status="deleted"
 	// (instead of 404) when show_deleted=true; the resource layer treats that the
 	// same as "inactive" and drops the resource from state.
 	path := fmt.Sprintf("%s/%s?show_deleted=true", ACCOUNT_PATH, accountId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
 	account := &Account{}
-	derr := json.NewDecoder(res.Body).Decode(account)
-
-	if derr != nil {
-		return nil, derr
+	if err := decodeJSON(res, "account", "get", account); err != nil {
+		return nil, err
 	}
 
 	if account.Status == "deleted" {
-		return account, fmt.Errorf("error getting account resource, the status is %s", account.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting account resource, got %d for %s", res.StatusCode, accountId)
+		return account, &ResourceNotFoundError{ApiType: "account", Message: fmt.Sprintf("the account is %s", account.Status)}
 	}
 
 	return account, nil
 }
 
-func (c *NullClient) DeleteAccount(accountId string) error {
+func (c *NullClient) DeleteAccount(ctx context.Context, accountId string) error {
 	path := fmt.Sprintf("%s/%s", ACCOUNT_PATH, accountId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
-		}
-		return fmt.Errorf("error deleting account, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "account", "delete")
 }
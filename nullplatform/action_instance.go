@@ -2,14 +2,16 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
 const ACTION_INSTANCE_PATH = "/service/%s/action"
 const ACTION_INSTANCE_ITEM_PATH = "/service/%s/action/%s"
+const LINK_ACTION_INSTANCE_PATH = "/link/%s/action"
+const LINK_ACTION_INSTANCE_ITEM_PATH = "/link/%s/action/%s"
 
 type ActionInstance struct {
 	Id              string                 `json:"id,omitempty"`
@@ -19,74 +21,69 @@ type ActionInstance struct {
 	Parameters      map[string]interface{} `json:"parameters,omitempty"`
 	Results         map[string]interface{} `json:"results,omitempty"`
 	Messages        []interface{}          `json:"messages,omitempty"`
+	CreatedAt       string                 `json:"created_at,omitempty"`
 }
 
-func (c *NullClient) CreateServiceAction(serviceID string, a *ActionInstance) (*ActionInstance, error) {
+func (c *NullClient) CreateServiceAction(ctx context.Context, serviceID string, a *ActionInstance) (*ActionInstance, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*a); err != nil {
 		return nil, err
 	}
 	path := fmt.Sprintf(ACTION_INSTANCE_PATH, serviceID)
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("error creating service action: status=%d body=%s", res.StatusCode, string(bodyBytes))
-	}
-
 	out := &ActionInstance{}
-	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+	if err := decodeJSON(res, "service action", "create", out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *NullClient) GetServiceAction(serviceID, actionID string) (*ActionInstance, error) {
+func (c *NullClient) GetServiceAction(ctx context.Context, serviceID, actionID string) (*ActionInstance, error) {
 	path := fmt.Sprintf(ACTION_INSTANCE_ITEM_PATH, serviceID, actionID)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("error getting service action: status=%d body=%s", res.StatusCode, string(bodyBytes))
-	}
-
 	out := &ActionInstance{}
-	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+	if err := decodeJSON(res, "service action", "get", out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *NullClient) PatchServiceAction(serviceID, actionID string, a *ActionInstance) (*ActionInstance, error) {
+// ListServiceActions lists the actions of a service, of every status.
+func (c *NullClient) ListServiceActions(ctx context.Context, serviceID string) ([]*ActionInstance, error) {
+	return listAll[*ActionInstance](ctx, c, fmt.Sprintf(ACTION_INSTANCE_PATH, serviceID), "service actions")
+}
+
+func (c *NullClient) PatchServiceAction(ctx context.Context, serviceID, actionID string, a *ActionInstance) (*ActionInstance, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*a); err != nil {
 		return nil, err
 	}
 	path := fmt.Sprintf(ACTION_INSTANCE_ITEM_PATH, serviceID, actionID)
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("error updating service action: status=%d body=%s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "service action", "update"); err != nil {
+		return nil, err
 	}
 
 	out := &ActionInstance{}
-	if res.StatusCode == http.StatusOK {
+	if res.StatusCode != http.StatusNoContent {
 		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
 			return nil, err
 		}
@@ -94,19 +91,56 @@ func (c *NullClient) PatchServiceAction(serviceID, actionID string, a *ActionIns
 	return out, nil
 }
 
-func (c *NullClient) DeleteServiceAction(serviceID, actionID string) error {
+func (c *NullClient) DeleteServiceAction(ctx context.Context, serviceID, actionID string) error {
 	path := fmt.Sprintf(ACTION_INSTANCE_ITEM_PATH, serviceID, actionID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("error deleting service action: status=%d body=%s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "service action", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func (c *NullClient) CreateLinkAction(ctx context.Context, linkID string, a *ActionInstance) (*ActionInstance, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(*a); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf(LINK_ACTION_INSTANCE_PATH, linkID)
+
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	out := &ActionInstance{}
+	if err := decodeJSON(res, "link action", "create", out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *NullClient) GetLinkAction(ctx context.Context, linkID, actionID string) (*ActionInstance, error) {
+	path := fmt.Sprintf(LINK_ACTION_INSTANCE_ITEM_PATH, linkID, actionID)
+
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	out := &ActionInstance{}
+	if err := decodeJSON(res, "link action", "get", out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
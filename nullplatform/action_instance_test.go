@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -24,7 +25,7 @@ func TestCreateServiceAction_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.CreateServiceAction("svc-9", &ActionInstance{
+	got, err := c.CreateServiceAction(context.Background(), "svc-9", &ActionInstance{
 		SpecificationId: "spec-1",
 		Parameters:      map[string]interface{}{"endpoint": "x"},
 	})
@@ -53,7 +54,7 @@ func TestCreateServiceAction_NonOkSurfaceStatusAndBody(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	_, err := c.CreateServiceAction("svc-9", &ActionInstance{SpecificationId: "spec-1"})
+	_, err := c.CreateServiceAction(context.Background(), "svc-9", &ActionInstance{SpecificationId: "spec-1"})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -77,7 +78,7 @@ func TestGetServiceAction_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.GetServiceAction("svc-9", "act-1")
+	got, err := c.GetServiceAction(context.Background(), "svc-9", "act-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -100,7 +101,7 @@ func TestGetServiceAction_NonOkSurfaceStatusAndBody(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	_, err := c.GetServiceAction("svc-9", "missing")
+	_, err := c.GetServiceAction(context.Background(), "svc-9", "missing")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -121,7 +122,7 @@ func TestPatchServiceAction_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.PatchServiceAction("svc-9", "act-1", &ActionInstance{Status: "in_progress"})
+	got, err := c.PatchServiceAction(context.Background(), "svc-9", "act-1", &ActionInstance{Status: "in_progress"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -147,7 +148,7 @@ func TestDeleteServiceAction_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	err := c.DeleteServiceAction("svc-9", "act-1")
+	err := c.DeleteServiceAction(context.Background(), "svc-9", "act-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -166,7 +167,7 @@ func TestDeleteServiceAction_NotFoundIsTolerated(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	if err := c.DeleteServiceAction("svc-9", "missing"); err != nil {
+	if err := c.DeleteServiceAction(context.Background(), "svc-9", "missing"); err != nil {
 		t.Fatalf("expected nil error on 404, got %v", err)
 	}
 }
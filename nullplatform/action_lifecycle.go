@@ -0,0 +1,308 @@
+package nullplatform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// actionPollInterval is how often a running action is polled. A variable so
+// tests can shorten it.
+var actionPollInterval = 15 * time.Second
+
+// actionCancelTimeout is how long an interrupted action is given to stop
+// once its cancellation was requested.
+var actionCancelTimeout = time.Minute
+
+// actionInProgressStatuses are the statuses of an action that has not ended.
+var actionInProgressStatuses = []string{"pending_create", "pending", "in_progress"}
+
+// What to do when an entity already has an action in progress, set by the
+// on_action_in_progress attribute.
+const (
+	onActionInProgressWait  = "wait"
+	onActionInProgressAdopt = "adopt"
+	onActionInProgressFail  = "fail"
+)
+
+// entityActions creates and reads the actions of one service or link, so
+// both resources drive their specification's actions the same way. list and
+// cancel are nil for links, whose actions can be neither listed nor
+// cancelled.
+type entityActions struct {
+	entity string
+	id     string
+	create func(ctx context.Context, a *ActionInstance) (*ActionInstance, error)
+	get    func(ctx context.Context, actionID string) (*ActionInstance, error)
+	list   func(ctx context.Context) ([]*ActionInstance, error)
+	cancel func(ctx context.Context, actionID string) error
+
+	// onInProgress is what run does about an action already in progress,
+	// one of the onActionInProgress constants; "" waits for it.
+	onInProgress string
+}
+
+func serviceActions(nullOps NullOps, serviceID string) *entityActions {
+	return &entityActions{
+		entity: "service",
+		id:     serviceID,
+		create: func(ctx context.Context, a *ActionInstance) (*ActionInstance, error) {
+			return nullOps.CreateServiceAction(ctx, serviceID, a)
+		},
+		get: func(ctx context.Context, actionID string) (*ActionInstance, error) {
+			return nullOps.GetServiceAction(ctx, serviceID, actionID)
+		},
+		list: func(ctx context.Context) ([]*ActionInstance, error) {
+			return nullOps.ListServiceActions(ctx, serviceID)
+		},
+		cancel: func(ctx context.Context, actionID string) error {
+			_, err := nullOps.PatchServiceAction(ctx, serviceID, actionID, &ActionInstance{Status: "cancelled"})
+			return err
+		},
+	}
+}
+
+func linkActions(nullOps NullOps, linkID string) *entityActions {
+	return &entityActions{
+		entity: "link",
+		id:     linkID,
+		create: func(ctx context.Context, a *ActionInstance) (*ActionInstance, error) {
+			return nullOps.CreateLinkAction(ctx, linkID, a)
+		},
+		get: func(ctx context.Context, actionID string) (*ActionInstance, error) {
+			return nullOps.GetLinkAction(ctx, linkID, actionID)
+		},
+	}
+}
+
+// trigger runs the action of type actionType among specs, the action
+// specifications of specificationID, and returns its specification and the
+// finished action.
+func (e *entityActions) trigger(ctx context.Context, specs []*ActionSpecification, specificationID, actionType string, attributes map[string]interface{}, timeout time.Duration) (*ActionSpecification, *ActionInstance, error) {
+	actionSpec, err := findActionSpecByType(specs, actionType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("specification %s: %w", specificationID, err)
+	}
+	action, err := e.run(ctx, actionSpec, attributes, timeout)
+	return actionSpec, action, err
+}
+
+// run creates an action of actionSpec, with the attributes its parameters
+// declare, and returns it once it succeeded. An action of actionSpec already
+// in progress, left by an interrupted apply, is adopted instead when
+// onInProgress says so.
+func (e *entityActions) run(ctx context.Context, actionSpec *ActionSpecification, attributes map[string]interface{}, timeout time.Duration) (*ActionInstance, error) {
+	deadline := time.Now().Add(timeout)
+
+	parameters, err := projectAttributesToParameters(attributes, actionSpec.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("projecting attributes onto %s action parameter schema: %w", actionSpec.Type, err)
+	}
+
+	action, err := e.inProgress(ctx, actionSpec.Id, time.Until(deadline))
+	if err != nil {
+		return nil, err
+	}
+	if action == nil {
+		if err := e.beforeDeadline(deadline); err != nil {
+			return nil, err
+		}
+		action, err = e.create(ctx, &ActionInstance{
+			SpecificationId: actionSpec.Id,
+			Parameters:      parameters,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("creating %s action: %w", actionSpec.Type, err)
+		}
+	}
+
+	return e.waitOrCancel(ctx, action.Id, time.Until(deadline))
+}
+
+// inProgress looks for an action of the entity that has not ended, before a
+// new one of specificationID is started. It returns the action to adopt, if
+// onInProgress is adopt and the action is of the same specification;
+// otherwise it waits for the action to end, or fails when onInProgress is
+// fail. Entities whose actions cannot be listed are not checked.
+func (e *entityActions) inProgress(ctx context.Context, specificationID string, timeout time.Duration) (*ActionInstance, error) {
+	if e.list == nil {
+		return nil, nil
+	}
+	actions, err := e.list(ctx)
+	if err != nil {
+		log.Printf("[WARN] not checking for actions in progress on %s %s: listing its actions failed: %v", e.entity, e.id, err)
+		return nil, nil
+	}
+
+	var running *ActionInstance
+	for _, a := range actions {
+		if slices.Contains(actionInProgressStatuses, a.Status) {
+			running = a
+			break
+		}
+	}
+	if running == nil {
+		return nil, nil
+	}
+
+	switch {
+	case e.onInProgress == onActionInProgressFail:
+		return nil, fmt.Errorf("%s %s already has action %s in progress, possibly left by an interrupted apply; "+
+			"wait for it to end, or set on_action_in_progress to %q or %q", e.entity, e.id, running.Id,
+			onActionInProgressWait, onActionInProgressAdopt)
+	case e.onInProgress == onActionInProgressAdopt && running.SpecificationId == specificationID:
+		log.Printf("[INFO] adopting action %s in progress on %s %s instead of starting a new one", running.Id, e.entity, e.id)
+		return running, nil
+	}
+
+	log.Printf("[INFO] waiting for action %s in progress on %s %s before starting a new one", running.Id, e.entity, e.id)
+	if finished, err := e.wait(ctx, running.Id, timeout); err != nil && finished == nil {
+		// It failing is its own business; only not seeing it end is ours.
+		return nil, fmt.Errorf("waiting for action %s in progress on %s %s: %w", running.Id, e.entity, e.id, err)
+	}
+	return nil, nil
+}
+
+// beforeDeadline returns an error once deadline has passed, so no action is
+// started when the time allowed for it was spent waiting for another one: it
+// would time out at once and be cancelled.
+func (e *entityActions) beforeDeadline(deadline time.Time) error {
+	if time.Now().Before(deadline) {
+		return nil
+	}
+	return fmt.Errorf("timed out before starting an action on %s %s: the time allowed was spent waiting for the "+
+		"action already in progress; raise the timeout or apply again", e.entity, e.id)
+}
+
+// waitOrCancel waits for an action started or adopted by the provider. If
+// the wait is interrupted or times out, the action is cancelled so it does
+// not collide with the next apply, and the error names it.
+func (e *entityActions) waitOrCancel(ctx context.Context, actionID string, timeout time.Duration) (*ActionInstance, error) {
+	a, err := e.wait(ctx, actionID, timeout)
+	var timeoutErr *retry.TimeoutError
+	if err == nil || (ctx.Err() == nil && !errors.As(err, &timeoutErr)) {
+		return a, err
+	}
+	return a, e.cancelInterrupted(actionID, err)
+}
+
+// cancelInterrupted requests the cancellation of an action Terraform
+// stopped waiting for and waits up to actionCancelTimeout for it to end. The
+// context of the apply is done by then, so it uses its own.
+func (e *entityActions) cancelInterrupted(actionID string, interruption error) error {
+	orphaned := &OrphanedActionError{Entity: e.entity, EntityID: e.id, ActionID: actionID, Err: interruption, detected: e.list != nil}
+	if e.cancel == nil {
+		return orphaned
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), actionCancelTimeout)
+	defer cancel()
+
+	if err := e.cancel(ctx, actionID); err != nil {
+		log.Printf("[WARN] cancelling action %s of %s %s failed: %v", actionID, e.entity, e.id, err)
+		return orphaned
+	}
+	for {
+		a, err := e.get(ctx, actionID)
+		if err == nil && !slices.Contains(actionInProgressStatuses, a.Status) {
+			orphaned.Status = a.Status
+			return orphaned
+		}
+		select {
+		case <-ctx.Done():
+			return orphaned
+		case <-time.After(actionPollInterval):
+		}
+	}
+}
+
+// OrphanedActionError is returned when Terraform stopped waiting for an
+// action, because it was interrupted or timed out. Status is the status the
+// action ended in once cancelled, or "" when it may still be running.
+type OrphanedActionError struct {
+	Entity   string
+	EntityID string
+	ActionID string
+	Status   string
+	Err      error
+
+	// detected is whether the next apply finds the action if it is still
+	// running.
+	detected bool
+}
+
+func (e *OrphanedActionError) Error() string {
+	switch {
+	case e.Status != "":
+		return fmt.Sprintf("%v; action %s of %s %s was cancelled and ended in status %q", e.Err, e.ActionID, e.Entity, e.EntityID, e.Status)
+	case e.detected:
+		return fmt.Sprintf("%v; action %s of %s %s may still be running, and the next apply waits for it or "+
+			"adopts it as on_action_in_progress says", e.Err, e.ActionID, e.Entity, e.EntityID)
+	default:
+		return fmt.Sprintf("%v; action %s of %s %s may still be running, check it in nullplatform before applying again",
+			e.Err, e.ActionID, e.Entity, e.EntityID)
+	}
+}
+
+func (e *OrphanedActionError) Unwrap() error {
+	return e.Err
+}
+
+// wait polls the action until it succeeds, fails or timeout elapses. A
+// failed or cancelled action is an error carrying its last message, returned
+// along with the action.
+func (e *entityActions) wait(ctx context.Context, actionID string, timeout time.Duration) (*ActionInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: actionInProgressStatuses,
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			a, err := e.get(ctx, actionID)
+			if err != nil {
+				return nil, "", err
+			}
+			if a.Status == "failed" || a.Status == "cancelled" {
+				return a, a.Status, fmt.Errorf("action %s ended in status %q: %s",
+					actionID, a.Status, summarizeMessages(a.Messages))
+			}
+			return a, a.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      actionPollInterval,
+		MinTimeout: actionPollInterval,
+	}
+	raw, err := stateConf.WaitForStateContext(ctx)
+	a, _ := raw.(*ActionInstance)
+	return a, err
+}
+
+// recoverFailedUpdate puts the attributes a failed update action was run
+// with back to what they were. If that fails too, the entity is marked
+// failed, so the next destroy deletes it directly instead of running its
+// delete action against half-updated infrastructure.
+func recoverFailedUpdate(ctx context.Context, entity, id string, actionErr error, restore, markFailed func() error) diag.Diagnostics {
+	restoreErr := restore()
+	if restoreErr == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Update Action Failed",
+			Detail:   fmt.Sprintf("The update action of %s %s failed: %v\n\nIts previous attributes were restored.", entity, id, actionErr),
+		}}
+	}
+
+	log.Printf("[WARN] restoring the attributes of %s %s failed, marking it failed: %v", entity, id, restoreErr)
+	detail := fmt.Sprintf("The update action of %s %s failed: %v\n\nRestoring its previous attributes failed too (%v), so it was marked as failed.", entity, id, actionErr, restoreErr)
+	if err := markFailed(); err != nil {
+		detail = fmt.Sprintf("The update action of %s %s failed: %v\n\nRestoring its previous attributes (%v) and marking it as failed (%v) failed too; check the %s in nullplatform.", entity, id, actionErr, restoreErr, err, entity)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Update Action Failed",
+		Detail:   detail,
+	}}
+}
@@ -0,0 +1,185 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// runningActionServer fakes service svc-1 with action act-1 in progress until
+// it is cancelled, or until it has been polled finishAfter times.
+type runningActionServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	status      string
+	polls       int
+	finishAfter int
+	cancelled   bool
+	posted      int
+}
+
+func newRunningActionServer(t *testing.T) *runningActionServer {
+	t.Helper()
+	s := &runningActionServer{status: "in_progress"}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/service/svc-1/action" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{"results": []ActionInstance{
+				{Id: "act-0", Status: "success", SpecificationId: "spec-1"},
+				{Id: "act-1", Status: s.status, SpecificationId: "spec-1"},
+			}})
+		case r.URL.Path == "/service/svc-1/action" && r.Method == http.MethodPost:
+			s.posted++
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-2", Status: "pending"})
+		case r.URL.Path == "/service/svc-1/action/act-1" && r.Method == http.MethodPatch:
+			var body ActionInstance
+			json.NewDecoder(r.Body).Decode(&body)
+			if body.Status == "cancelled" {
+				s.cancelled = true
+				s.status = "cancelled"
+			}
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-1", Status: s.status})
+		case r.URL.Path == "/service/svc-1/action/act-1":
+			s.polls++
+			if s.finishAfter > 0 && s.polls >= s.finishAfter {
+				s.status = "success"
+			}
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-1", Status: s.status, SpecificationId: "spec-1"})
+		case r.URL.Path == "/service/svc-1/action/act-2":
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-2", Status: "success", SpecificationId: "spec-1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func TestWaitOrCancel_CancelsInterruptedAction(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		timeout time.Duration
+		cancel  bool
+	}{
+		{name: "timeout", timeout: 20 * time.Millisecond},
+		{name: "interrupted", timeout: time.Minute, cancel: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			shortenActionPolling(t)
+			server := newRunningActionServer(t)
+			defer server.Close()
+			client := newTestClient(server.Server)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancel {
+				time.AfterFunc(20*time.Millisecond, cancel)
+			}
+
+			_, err := serviceActions(client, "svc-1").waitOrCancel(ctx, "act-1", tc.timeout)
+			var orphanedErr *OrphanedActionError
+			if !errors.As(err, &orphanedErr) {
+				t.Fatalf("err = %v, want an OrphanedActionError", err)
+			}
+			if !server.cancelled {
+				t.Error("the action was not cancelled")
+			}
+			if orphanedErr.ActionID != "act-1" || orphanedErr.Status != "cancelled" {
+				t.Errorf("err = %+v, want act-1 cancelled", orphanedErr)
+			}
+			if diags := diagFromErr(err); diags[0].Summary != "Action Interrupted" || !strings.Contains(diags[0].Detail, "act-1") {
+				t.Errorf("diags = %v, want Action Interrupted naming act-1", diags)
+			}
+		})
+	}
+}
+
+func TestInProgress(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		onInProgress  string
+		specification string
+		wantAdopted   bool
+		wantError     bool
+		wantPolled    bool
+	}{
+		{name: "adopt same specification", onInProgress: onActionInProgressAdopt, specification: "spec-1", wantAdopted: true},
+		{name: "adopt other specification waits", onInProgress: onActionInProgressAdopt, specification: "spec-2", wantPolled: true},
+		{name: "wait", onInProgress: onActionInProgressWait, specification: "spec-1", wantPolled: true},
+		{name: "default waits", specification: "spec-1", wantPolled: true},
+		{name: "fail", onInProgress: onActionInProgressFail, specification: "spec-1", wantError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			shortenActionPolling(t)
+			server := newRunningActionServer(t)
+			server.finishAfter = 2
+			defer server.Close()
+			client := newTestClient(server.Server)
+
+			actions := serviceActions(client, "svc-1")
+			actions.onInProgress = tc.onInProgress
+			adopted, err := actions.inProgress(context.Background(), tc.specification, time.Minute)
+			if (err != nil) != tc.wantError {
+				t.Fatalf("err = %v, want error %v", err, tc.wantError)
+			}
+			if tc.wantError && !strings.Contains(err.Error(), "act-1") {
+				t.Errorf("err = %v, want it to name act-1", err)
+			}
+			if (adopted != nil) != tc.wantAdopted {
+				t.Errorf("adopted = %+v, want adopted %v", adopted, tc.wantAdopted)
+			}
+			if (server.polls > 0) != tc.wantPolled {
+				t.Errorf("polled act-1 %d times, want polled %v", server.polls, tc.wantPolled)
+			}
+		})
+	}
+}
+
+func TestServiceActionCreate_AdoptsActionInProgress(t *testing.T) {
+	shortenActionPolling(t)
+	server := newRunningActionServer(t)
+	server.finishAfter = 2
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceActionData(t, map[string]interface{}{"on_action_in_progress": "adopt"})
+	if diags := ServiceActionCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if server.posted != 0 || d.Id() != "act-1" {
+		t.Errorf("posted %d actions and id = %q, want act-1 adopted", server.posted, d.Id())
+	}
+}
+
+func TestRun_DoesNotStartActionAfterDeadline(t *testing.T) {
+	created := 0
+	actions := &entityActions{
+		entity: "service",
+		id:     "svc-1",
+		list: func(ctx context.Context) ([]*ActionInstance, error) {
+			time.Sleep(20 * time.Millisecond)
+			return nil, nil
+		},
+		create: func(ctx context.Context, a *ActionInstance) (*ActionInstance, error) {
+			created++
+			return &ActionInstance{Id: "act-2", Status: "pending"}, nil
+		},
+	}
+
+	_, err := actions.run(context.Background(), &ActionSpecification{Id: "spec-1", Type: "update"}, nil, 10*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out before starting an action") {
+		t.Fatalf("err = %v, want a timeout before starting the action", err)
+	}
+	if created != 0 {
+		t.Errorf("created %d actions, want none", created)
+	}
+}
@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 type ActionSpecification struct {
@@ -34,7 +33,7 @@ func getActionSpecificationPath(parentType, parentId string) string {
 	return fmt.Sprintf("/link_specification/%s/action_specification", parentId)
 }
 
-func (c *NullClient) CreateActionSpecification(s *ActionSpecification) (*ActionSpecification, error) {
+func (c *NullClient) CreateActionSpecification(ctx context.Context, s *ActionSpecification) (*ActionSpecification, error) {
 	// Determine which parent ID to use
 	var parentType, parentId string
 	if s.ServiceSpecificationId != "" {
@@ -53,15 +52,14 @@ func (c *NullClient) CreateActionSpecification(s *ActionSpecification) (*ActionS
 		return nil, fmt.Errorf("failed to encode action specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create action specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "action specification", "create"); err != nil {
+		return nil, err
 	}
 
 	sRes := &ActionSpecification{}
@@ -72,18 +70,17 @@ func (c *NullClient) CreateActionSpecification(s *ActionSpecification) (*ActionS
 	return sRes, nil
 }
 
-func (c *NullClient) GetActionSpecification(specId, parentType, parentId string) (*ActionSpecification, error) {
+func (c *NullClient) GetActionSpecification(ctx context.Context, specId, parentType, parentId string) (*ActionSpecification, error) {
 	path := fmt.Sprintf("/action_specification/%s", specId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get action specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "action specification", "get"); err != nil {
+		return nil, err
 	}
 
 	spec := &ActionSpecification{}
@@ -94,7 +91,7 @@ func (c *NullClient) GetActionSpecification(specId, parentType, parentId string)
 	return spec, nil
 }
 
-func (c *NullClient) PatchActionSpecification(specId string, s *ActionSpecification, parentType, parentId string) error {
+func (c *NullClient) PatchActionSpecification(ctx context.Context, specId string, s *ActionSpecification, parentType, parentId string) error {
 	basePath := getActionSpecificationPath(parentType, parentId)
 	path := fmt.Sprintf("%s/%s", basePath, specId)
 
@@ -104,84 +101,44 @@ func (c *NullClient) PatchActionSpecification(specId string, s *ActionSpecificat
 		return fmt.Errorf("failed to encode action specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch action specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "action specification", "update")
 }
 
-func (c *NullClient) ListActionSpecifications(serviceSpecId string) ([]*ActionSpecification, error) {
+func (c *NullClient) ListActionSpecifications(ctx context.Context, serviceSpecId string) ([]*ActionSpecification, error) {
 	path := fmt.Sprintf("/service_specification/%s/action_specification", serviceSpecId)
 
-	res, err := c.MakeRequest("GET", path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make API request: %v", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to list action specifications: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	var result struct {
-		Results []*ActionSpecification `json:"results"`
-	}
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %v", err)
-	}
-
-	return result.Results, nil
+	return listAllCached[*ActionSpecification](ctx, c, path, "action specifications")
 }
 
 // ListLinkActionSpecifications lists the action specifications owned by a link
 // specification (GET /link_specification/:id/action_specification). Mirrors
 // ListActionSpecifications, which is service-specification-only.
-func (c *NullClient) ListLinkActionSpecifications(linkSpecId string) ([]*ActionSpecification, error) {
+func (c *NullClient) ListLinkActionSpecifications(ctx context.Context, linkSpecId string) ([]*ActionSpecification, error) {
 	path := fmt.Sprintf("/link_specification/%s/action_specification", linkSpecId)
 
-	res, err := c.MakeRequest("GET", path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make API request: %v", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to list link action specifications: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	var result struct {
-		Results []*ActionSpecification `json:"results"`
-	}
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %v", err)
-	}
-
-	return result.Results, nil
+	return listAllCached[*ActionSpecification](ctx, c, path, "link action specifications")
 }
 
-func (c *NullClient) DeleteActionSpecification(specId string, parentType, parentId string) error {
+func (c *NullClient) DeleteActionSpecification(ctx context.Context, specId string, parentType, parentId string) error {
 	basePath := getActionSpecificationPath(parentType, parentId)
 	path := fmt.Sprintf("%s/%s", basePath, specId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete action specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "action specification", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const API_KEY_PATH = "/api_key"
@@ -45,18 +44,17 @@ type PatchApiKeyRequestBody struct {
 	Tags   []Tag         `json:"tags,omitempty"`
 }
 
-func (c *NullClient) GetApiKey(apiKeyId int64) (*ApiKey, error) {
+func (c *NullClient) GetApiKey(ctx context.Context, apiKeyId int64) (*ApiKey, error) {
 	path := fmt.Sprintf("%s/%d", API_KEY_PATH, apiKeyId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get API Key resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "API key", "get"); err != nil {
+		return nil, err
 	}
 
 	apiKey := &ApiKey{}
@@ -68,22 +66,21 @@ func (c *NullClient) GetApiKey(apiKeyId int64) (*ApiKey, error) {
 	return apiKey, nil
 }
 
-func (c *NullClient) CreateApiKey(body *CreateApiKeyRequestBody) (*CreateApiKeyResponseBody, error) {
+func (c *NullClient) CreateApiKey(ctx context.Context, body *CreateApiKeyRequestBody) (*CreateApiKeyResponseBody, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode api key: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", API_KEY_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", API_KEY_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create API Key resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "API key", "create"); err != nil {
+		return nil, err
 	}
 
 	apiKey := &CreateApiKeyResponseBody{}
@@ -95,7 +92,7 @@ func (c *NullClient) CreateApiKey(body *CreateApiKeyRequestBody) (*CreateApiKeyR
 	return apiKey, nil
 }
 
-func (c *NullClient) PatchApiKey(apiKeyId int64, req *PatchApiKeyRequestBody) error {
+func (c *NullClient) PatchApiKey(ctx context.Context, apiKeyId int64, req *PatchApiKeyRequestBody) error {
 	path := fmt.Sprintf("%s/%d", API_KEY_PATH, apiKeyId)
 
 	var buf bytes.Buffer
@@ -104,33 +101,23 @@ func (c *NullClient) PatchApiKey(apiKeyId int64, req *PatchApiKeyRequestBody) er
 		return fmt.Errorf("failed to encode api key: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch API Key resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "API key", "update")
 }
 
-func (c *NullClient) DeleteApiKey(apiKeyId int64) error {
+func (c *NullClient) DeleteApiKey(ctx context.Context, apiKeyId int64) error {
 	path := fmt.Sprintf("%s/%d", API_KEY_PATH, apiKeyId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete API Key resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "API key", "delete")
 }
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const APPLICATION_PATH = "/application"
@@ -26,35 +26,33 @@ type Application struct {
 	Nrn                  string                 `json:"nrn,omitempty"`
 }
 
-func (c *NullClient) CreateApplication(application *Application) (*Application, error) {
+func (c *NullClient) CreateApplication(ctx context.Context, application *Application) (created *Application, adoptedAfter error, err error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*application); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.MakeRequest("POST", APPLICATION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", APPLICATION_PATH, &buf)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
+		if isAmbiguousRequestError(err) {
+			return c.reconcileApplicationCreate(ctx, application, err)
 		}
-		return nil, fmt.Errorf("error creating application resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+		return nil, nil, err
 	}
+	defer res.Body.Close()
 
 	app := &Application{}
-	if err := json.NewDecoder(res.Body).Decode(app); err != nil {
-		return nil, err
+	if err := decodeJSON(res, "application", "create", app); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileApplicationCreate(ctx, application, err)
+		}
+		return nil, nil, err
 	}
 
-	return app, nil
+	return app, nil, nil
 }
 
-func (c *NullClient) PatchApplication(appId string, application *Application) error {
+func (c *NullClient) PatchApplication(ctx context.Context, appId string, application *Application) error {
 	path := fmt.Sprintf("%s/%s", APPLICATION_PATH, appId)
 
 	var buf bytes.Buffer
@@ -62,52 +60,46 @@ func (c *NullClient) PatchApplication(appId string, application *Application) er
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating application resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "application", "update")
 }
 
-func (c *NullClient) DeleteApplication(appId string) error {
+func (c *NullClient) DeleteApplication(ctx context.Context, appId string) error {
 	path := fmt.Sprintf("%s/%s", APPLICATION_PATH, appId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "application", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting application, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
 }
 
-func (c *NullClient) GetApplication(appId string) (*Application, error) {
+func (c *NullClient) GetApplication(ctx context.Context, appId string) (*Application, error) {
 	path := fmt.Sprintf("%s/%s", APPLICATION_PATH, appId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "application", "get"); err != nil {
+		return nil, err
+	}
+
 	app := &Application{}
 	derr := json.NewDecoder(res.Body).Decode(app)
 
@@ -115,9 +107,5 @@ func (c *NullClient) GetApplication(appId string) (*Application, error) {
 		return nil, derr
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting application resource, got %d for %s", res.StatusCode, appId)
-	}
-
 	return app, nil
 }
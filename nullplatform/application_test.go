@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -21,7 +22,7 @@ func TestCreateApplication_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.CreateApplication(&Application{Name: "my-api", NamespaceId: 42, RepositoryUrl: "https://example.com/repo"})
+	got, _, err := c.CreateApplication(context.Background(), &Application{Name: "my-api", NamespaceId: 42, RepositoryUrl: "https://example.com/repo"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -52,7 +53,7 @@ func TestPatchApplication_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	err := c.PatchApplication("7", &Application{Name: "renamed"})
+	err := c.PatchApplication(context.Background(), "7", &Application{Name: "renamed"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestDeleteApplication_NotFoundIsTolerated(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	if err := c.DeleteApplication("missing"); err != nil {
+	if err := c.DeleteApplication(context.Background(), "missing"); err != nil {
 		t.Fatalf("expected nil error on 404, got %v", err)
 	}
 }
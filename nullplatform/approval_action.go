@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const APPROVAL_ACTION_PATH = "/approval/action"
@@ -21,7 +21,7 @@ type ApprovalAction struct {
 	Policies        []*ApprovalPolicy `json:"policies,omitempty"`
 }
 
-func (c *NullClient) CreateApprovalAction(action *ApprovalAction) (*ApprovalAction, error) {
+func (c *NullClient) CreateApprovalAction(ctx context.Context, action *ApprovalAction) (*ApprovalAction, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*action)
 
@@ -29,19 +29,14 @@ func (c *NullClient) CreateApprovalAction(action *ApprovalAction) (*ApprovalActi
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", APPROVAL_ACTION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", APPROVAL_ACTION_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode error response: %w", err)
-		}
-		defer res.Body.Close()
-		return nil, fmt.Errorf("error creating approval action resource, got status code: %d, %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "approval action", "create"); err != nil {
+		return nil, err
 	}
 
 	actionRes := &ApprovalAction{}
@@ -54,7 +49,7 @@ func (c *NullClient) CreateApprovalAction(action *ApprovalAction) (*ApprovalActi
 	return actionRes, nil
 }
 
-func (c *NullClient) PatchApprovalAction(approvalActionId string, action *ApprovalAction) error {
+func (c *NullClient) PatchApprovalAction(ctx context.Context, approvalActionId string, action *ApprovalAction) error {
 	path := fmt.Sprintf("%s/%s", APPROVAL_ACTION_PATH, approvalActionId)
 
 	var buf bytes.Buffer
@@ -64,28 +59,28 @@ func (c *NullClient) PatchApprovalAction(approvalActionId string, action *Approv
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error patching approval action resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "approval action", "update")
 }
 
-func (c *NullClient) GetApprovalAction(approvalActionId string) (*ApprovalAction, error) {
+func (c *NullClient) GetApprovalAction(ctx context.Context, approvalActionId string) (*ApprovalAction, error) {
 	path := fmt.Sprintf("%s/%s", APPROVAL_ACTION_PATH, approvalActionId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "approval action", "get"); err != nil {
+		return nil, err
+	}
+
 	action := &ApprovalAction{}
 	derr := json.NewDecoder(res.Body).Decode(action)
 
@@ -94,33 +89,25 @@ func (c *NullClient) GetApprovalAction(approvalActionId string) (*ApprovalAction
 	}
 
 	if action.Status == "deleted" {
-		return action, fmt.Errorf("error getting approval action resource, the status is %s", action.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting approval action resource, got %d for %s", res.StatusCode, approvalActionId)
+		return action, &ResourceNotFoundError{ApiType: "approval action", Message: fmt.Sprintf("the approval action is %s", action.Status)}
 	}
 
 	return action, nil
 }
 
-func (c *NullClient) DeleteApprovalAction(approvalActionId string) error {
+func (c *NullClient) DeleteApprovalAction(ctx context.Context, approvalActionId string) error {
 	path := fmt.Sprintf("%s/%s", APPROVAL_ACTION_PATH, approvalActionId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting approval action resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "approval action", "delete")
 }
 
-func (c *NullClient) AssociatePolicyWithAction(approvalActionId, approvalPolicyID string) error {
+func (c *NullClient) AssociatePolicyWithAction(ctx context.Context, approvalActionId, approvalPolicyID string) error {
 	var buf bytes.Buffer
 	path := fmt.Sprintf("%s/%s/policy", APPROVAL_ACTION_PATH, approvalActionId)
 
@@ -133,36 +120,23 @@ func (c *NullClient) AssociatePolicyWithAction(approvalActionId, approvalPolicyI
 		return err
 	}
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusCreated) {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
-		}
-		defer res.Body.Close()
-		return fmt.Errorf("error associating approval policy with action, got status code: %d, %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "approval policy", "associate")
 }
 
-func (c *NullClient) DisassociatePolicyFromAction(approvalActionId, approvalPolicyID string) error {
+func (c *NullClient) DisassociatePolicyFromAction(ctx context.Context, approvalActionId, approvalPolicyID string) error {
 	path := fmt.Sprintf("%s/%s/policy/%s", APPROVAL_ACTION_PATH, approvalActionId, approvalPolicyID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting approval action and policy association, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "approval policy", "disassociate")
 }
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const APPROVAL_POLICY_PATH = "/approval/policy"
@@ -18,7 +18,7 @@ type ApprovalPolicy struct {
 	Status     string      `json:"status,omitempty"`
 }
 
-func (c *NullClient) CreateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPolicy, error) {
+func (c *NullClient) CreateApprovalPolicy(ctx context.Context, policy *ApprovalPolicy) (*ApprovalPolicy, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*policy)
 
@@ -26,18 +26,14 @@ func (c *NullClient) CreateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPoli
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", APPROVAL_POLICY_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", APPROVAL_POLICY_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating approval policy resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "approval policy", "create"); err != nil {
+		return nil, err
 	}
 
 	policyRes := &ApprovalPolicy{}
@@ -50,7 +46,7 @@ func (c *NullClient) CreateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPoli
 	return policyRes, nil
 }
 
-func (c *NullClient) PatchApprovalPolicy(ApprovalPolicyId string, policy *ApprovalPolicy) error {
+func (c *NullClient) PatchApprovalPolicy(ctx context.Context, ApprovalPolicyId string, policy *ApprovalPolicy) error {
 	path := fmt.Sprintf("%s/%s", APPROVAL_POLICY_PATH, ApprovalPolicyId)
 
 	var buf bytes.Buffer
@@ -60,28 +56,28 @@ func (c *NullClient) PatchApprovalPolicy(ApprovalPolicyId string, policy *Approv
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error patching approval policy resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "approval policy", "update")
 }
 
-func (c *NullClient) GetApprovalPolicy(ApprovalPolicyId string) (*ApprovalPolicy, error) {
+func (c *NullClient) GetApprovalPolicy(ctx context.Context, ApprovalPolicyId string) (*ApprovalPolicy, error) {
 	path := fmt.Sprintf("%s/%s", APPROVAL_POLICY_PATH, ApprovalPolicyId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "approval policy", "get"); err != nil {
+		return nil, err
+	}
+
 	policy := &ApprovalPolicy{}
 	derr := json.NewDecoder(res.Body).Decode(policy)
 
@@ -90,32 +86,20 @@ func (c *NullClient) GetApprovalPolicy(ApprovalPolicyId string) (*ApprovalPolicy
 	}
 
 	if policy.Status == "deleted" {
-		return policy, fmt.Errorf("error getting approval policy resource, the status is %s", policy.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting approval policy resource, got %d for %s", res.StatusCode, ApprovalPolicyId)
+		return policy, &ResourceNotFoundError{ApiType: "approval policy", Message: fmt.Sprintf("the approval policy is %s", policy.Status)}
 	}
 
 	return policy, nil
 }
 
-func (c *NullClient) DeleteApprovalPolicy(approvalPolicyId string) error {
+func (c *NullClient) DeleteApprovalPolicy(ctx context.Context, approvalPolicyId string) error {
 	path := fmt.Sprintf("%s/%s", APPROVAL_POLICY_PATH, approvalPolicyId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error deleting approval policy resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "approval policy", "delete")
 }
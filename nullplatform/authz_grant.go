@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -19,22 +18,21 @@ type AuthzGrant struct {
 	NRN      string `json:"nrn"`
 }
 
-func (c *NullClient) CreateAuthzGrant(g *AuthzGrant) (*AuthzGrant, error) {
+func (c *NullClient) CreateAuthzGrant(ctx context.Context, g *AuthzGrant) (*AuthzGrant, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(g)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode authz grant: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", AUTHZ_GRANT_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", AUTHZ_GRANT_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create authz grant: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "authz grant", "create"); err != nil {
+		return nil, err
 	}
 
 	grant := &AuthzGrant{}
@@ -45,18 +43,17 @@ func (c *NullClient) CreateAuthzGrant(g *AuthzGrant) (*AuthzGrant, error) {
 	return grant, nil
 }
 
-func (c *NullClient) GetAuthzGrant(grantID string) (*AuthzGrant, error) {
+func (c *NullClient) GetAuthzGrant(ctx context.Context, grantID string) (*AuthzGrant, error) {
 	path := fmt.Sprintf("%s/%s", AUTHZ_GRANT_PATH, grantID)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get authz grant: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "authz grant", "get"); err != nil {
+		return nil, err
 	}
 
 	grant := &AuthzGrant{}
@@ -67,18 +64,19 @@ func (c *NullClient) GetAuthzGrant(grantID string) (*AuthzGrant, error) {
 	return grant, nil
 }
 
-func (c *NullClient) DeleteAuthzGrant(grantID string) error {
+func (c *NullClient) DeleteAuthzGrant(ctx context.Context, grantID string) error {
 	path := fmt.Sprintf("%s/%s", AUTHZ_GRANT_PATH, grantID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete authz grant: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "authz grant", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
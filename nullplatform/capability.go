@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const CAPABILITY_PATH = "/capability"
@@ -21,24 +21,20 @@ type CapabilityEntity struct {
 	UpdatedAt   string                 `json:"updated_at,omitempty"`
 }
 
-func (c *NullClient) CreateCapability(capability *CapabilityEntity) (*CapabilityEntity, error) {
+func (c *NullClient) CreateCapability(ctx context.Context, capability *CapabilityEntity) (*CapabilityEntity, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*capability); err != nil {
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", CAPABILITY_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", CAPABILITY_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating capability resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "capability", "create"); err != nil {
+		return nil, err
 	}
 
 	capabilityRes := &CapabilityEntity{}
@@ -49,17 +45,17 @@ func (c *NullClient) CreateCapability(capability *CapabilityEntity) (*Capability
 	return capabilityRes, nil
 }
 
-func (c *NullClient) GetCapability(capabilityId string) (*CapabilityEntity, error) {
+func (c *NullClient) GetCapability(ctx context.Context, capabilityId string) (*CapabilityEntity, error) {
 	path := fmt.Sprintf("%s/%s", CAPABILITY_PATH, capabilityId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting capability resource, got %d for %s", res.StatusCode, capabilityId)
+	if err := checkResponse(res, "capability", "get"); err != nil {
+		return nil, err
 	}
 
 	capability := &CapabilityEntity{}
@@ -70,7 +66,7 @@ func (c *NullClient) GetCapability(capabilityId string) (*CapabilityEntity, erro
 	return capability, nil
 }
 
-func (c *NullClient) PatchCapability(capabilityId string, capability *CapabilityEntity) error {
+func (c *NullClient) PatchCapability(ctx context.Context, capabilityId string, capability *CapabilityEntity) error {
 	path := fmt.Sprintf("%s/%s", CAPABILITY_PATH, capabilityId)
 
 	var buf bytes.Buffer
@@ -78,38 +74,28 @@ func (c *NullClient) PatchCapability(capabilityId string, capability *Capability
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating capability resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "capability", "update")
 }
 
-func (c *NullClient) DeleteCapability(capabilityId string) error {
+func (c *NullClient) DeleteCapability(ctx context.Context, capabilityId string) error {
 	path := fmt.Sprintf("%s/%s", CAPABILITY_PATH, capabilityId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "capability", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting capability, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -19,7 +20,7 @@ func TestCreateCapability_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.CreateCapability(&CapabilityEntity{Name: "cpu", Target: "scope", Definition: map[string]interface{}{"type": "object"}})
+	got, err := c.CreateCapability(context.Background(), &CapabilityEntity{Name: "cpu", Target: "scope", Definition: map[string]interface{}{"type": "object"}})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -45,7 +46,7 @@ func TestCreateDeploymentStrategy_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.CreateDeploymentStrategy(&DeploymentStrategy{Name: "rolling", Nrn: "organization=1"})
+	got, err := c.CreateDeploymentStrategy(context.Background(), &DeploymentStrategy{Name: "rolling", Nrn: "organization=1"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestCreateScopeDomain_HappyPath(t *testing.T) {
 	defer server.Close()
 
 	c := newTestClient(server)
-	got, err := c.CreateScopeDomain(&ScopeDomain{Name: "api.example.com", ScopeId: "99", Type: "custom"})
+	got, err := c.CreateScopeDomain(context.Background(), &ScopeDomain{Name: "api.example.com", ScopeId: "99", Type: "custom"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
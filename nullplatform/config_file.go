@@ -0,0 +1,220 @@
+package nullplatform
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const CONFIG_FILE_ENV = "NULLPLATFORM_CONFIG_FILE"
+
+// defaultProfileName is the profile used when none is selected, if the config
+// file has one by that name.
+const defaultProfileName = "default"
+
+// Profile is one section of the nullplatform config file:
+//
+//	[staging]
+//	host    = api.staging.nullplatform.io
+//	api_key = ...
+//	nrn     = organization=1234:account=5678
+//
+// At most one of APIKey, AccessToken and TokenFile is set.
+type Profile struct {
+	Name        string
+	Path        string
+	Host        string
+	APIKey      string
+	AccessToken string
+	TokenFile   string
+	NRN         string
+
+	// Selected is set when the profile was chosen with the profile attribute
+	// or NULLPLATFORM_PROFILE, rather than used as the default profile.
+	Selected bool
+}
+
+// hasCredentials reports whether the profile carries any credential.
+func (p *Profile) hasCredentials() bool {
+	return p != nil && (p.APIKey != "" || p.AccessToken != "" || p.TokenFile != "")
+}
+
+// selected reports whether the profile was chosen by name. A selected
+// profile wins as a unit over the NULLPLATFORM_* environment variables.
+func (p *Profile) selected() bool {
+	return p != nil && p.Selected
+}
+
+// source describes where a value read from the profile came from, for
+// diagnostics.
+func (p *Profile) source() string {
+	return fmt.Sprintf("profile %q in %s", p.Name, p.Path)
+}
+
+// organizationID returns the organization of the profile's default NRN, ""
+// when it has none.
+func (p *Profile) organizationID() string {
+	if p == nil {
+		return ""
+	}
+	nrn, err := ParseNRN(p.NRN)
+	if err != nil {
+		return ""
+	}
+	return nrn.ID("organization")
+}
+
+// configFilePath returns NULLPLATFORM_CONFIG_FILE, or ~/.nullplatform/config.
+func configFilePath() (string, error) {
+	if path := os.Getenv(CONFIG_FILE_ENV); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".nullplatform", "config"), nil
+}
+
+// loadProfile returns the profile selected with the profile attribute (or
+// NULLPLATFORM_PROFILE), or the default profile when none is selected and the
+// config file has one. It returns nil when no profile applies.
+func loadProfile(d *schema.ResourceData) (*Profile, diag.Diagnostics) {
+	name := d.Get(PROFILE).(string)
+
+	path, err := configFilePath()
+	if err != nil {
+		if name == "" {
+			return nil, nil
+		}
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unable To Locate Config File",
+			Detail:   fmt.Sprintf("Profile %q was requested but the config file could not be located: %v. Set %s to its path.", name, err, CONFIG_FILE_ENV),
+		}}
+	}
+
+	profiles, err := readConfigFile(path)
+	if os.IsNotExist(err) && name == "" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid Config File",
+			Detail:   fmt.Sprintf("Reading the nullplatform config file failed: %v", err),
+		}}
+	}
+
+	if name == "" {
+		profile, ok := profiles[defaultProfileName]
+		if !ok {
+			return nil, nil
+		}
+		return profile, nil
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Profile Not Found",
+			Detail:        fmt.Sprintf("Profile %q is not defined in %s. Available profiles: %s.", name, path, strings.Join(names, ", ")),
+			AttributePath: cty.GetAttrPath(PROFILE),
+		}}
+	}
+	profile.Selected = true
+	return profile, nil
+}
+
+// readConfigFile parses an INI style config file into its profiles. Blank
+// lines and lines starting with # or ; are ignored.
+func readConfigFile(path string) (map[string]*Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]*Profile{}
+	var current *Profile
+
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, lineNumber)
+			}
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("%s:%d: profile %q is defined twice", path, lineNumber, name)
+			}
+			current = &Profile{Name: name, Path: path}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value or [profile]", path, lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("%s:%d: %s is set outside of a [profile] section", path, lineNumber, strings.TrimSpace(key))
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch key {
+		case "host":
+			current.Host = value
+		case "api_key":
+			current.APIKey = value
+		case "access_token":
+			current.AccessToken = value
+		case "token_file":
+			current.TokenFile = value
+		case "nrn":
+			if nrn, err := ParseNRN(value); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, lineNumber, err)
+			} else if nrn.IsWildcard() {
+				return nil, fmt.Errorf("%s:%d: nrn %q cannot end in a wildcard", path, lineNumber, value)
+			}
+			current.NRN = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q, expected host, api_key, access_token, token_file or nrn", path, lineNumber, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		credentials := 0
+		for _, v := range []string{profile.APIKey, profile.AccessToken, profile.TokenFile} {
+			if v != "" {
+				credentials++
+			}
+		}
+		if credentials > 1 {
+			return nil, fmt.Errorf("%s: profile %q sets more than one of api_key, access_token and token_file", path, profile.Name)
+		}
+	}
+
+	return profiles, nil
+}
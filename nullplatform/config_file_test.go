@@ -0,0 +1,64 @@
+package nullplatform
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	content := `
+; comments start with ; or #
+[default]
+api_key = key = with = equals
+
+[prod]
+host       = api.nullplatform.com
+token_file = /var/run/nullplatform/token
+nrn        = organization=1:account=2
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := readConfigFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := profiles["default"].APIKey; got != "key = with = equals" {
+		t.Errorf("default api_key = %q, want everything after the first =", got)
+	}
+	prod := profiles["prod"]
+	if prod.Host != "api.nullplatform.com" || prod.TokenFile != "/var/run/nullplatform/token" || prod.organizationID() != "1" {
+		t.Errorf("prod = %+v", prod)
+	}
+}
+
+func TestReadConfigFile_Errors(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"key outside a profile": {"host = a", ":1: host is set outside"},
+		"unknown key":           {"[a]\nhots = a", `:2: unknown key "hots"`},
+		"not a key value pair":  {"[a]\nhost", ":2: expected key = value"},
+		"duplicate profile":     {"[a]\n[a]", `:2: profile "a" is defined twice`},
+		"invalid nrn":           {"[a]\nnrn = account=1", "must start with organization=<id>"},
+		"wildcard nrn":          {"[a]\nnrn = organization=1:account=*", "cannot end in a wildcard"},
+		"several credentials":   {"[a]\napi_key = k\naccess_token = t", "more than one of api_key, access_token and token_file"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := readConfigFile(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("readConfigFile() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
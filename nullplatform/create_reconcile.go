@@ -0,0 +1,247 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify derives the slug the API assigns to an entity created without an
+// explicit one: lowercase alphanumerics separated by single dashes.
+func slugify(name string) string {
+	return strings.Trim(slugSeparators.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// isAmbiguousRequestError reports whether a failed request may still have
+// been processed: it was sent (the failure is not a connection error) but no
+// response came back, e.g. a client timeout or a reset connection.
+func isAmbiguousRequestError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && !isConnectionError(err)
+}
+
+// isAmbiguousCreateStatus reports statuses after which a POST may have been
+// committed even though the response does not carry the entity. Gateways
+// answer 502/504 when the upstream is slow, not necessarily when it failed.
+func isAmbiguousCreateStatus(statusCode int) bool {
+	return statusCode >= http.StatusInternalServerError
+}
+
+// alreadyExistsCodes are the error codes the API answers a create whose
+// natural key is taken with, when it does not answer 409.
+var alreadyExistsCodes = map[string]bool{
+	"conflict":       true,
+	"already_exists": true,
+}
+
+// isAlreadyExistsResponse reports whether the API rejected a create because
+// the natural key is taken, which is what the retry of an ambiguous create
+// from a previous apply runs into. Only the status and the error code are
+// trusted: messages vary between services and also describe unrelated
+// failures.
+func isAlreadyExistsResponse(apiErr *APIError) bool {
+	return apiErr.Status == http.StatusConflict || alreadyExistsCodes[strings.ToLower(apiErr.Code)]
+}
+
+// adoptionWarning warns that an entity of apiType was adopted after the
+// create failure adoptedAfter rather than created, or returns nil when it was
+// created.
+func adoptionWarning(apiType string, adoptedAfter error) diag.Diagnostics {
+	if adoptedAfter == nil {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Existing " + strings.ToUpper(apiType[:1]) + apiType[1:] + " Adopted",
+		Detail: fmt.Sprintf("Creating the %s failed (%v), but a %s matching the configuration already exists under the same name, "+
+			"most likely created by an earlier apply that did not get the response. It was adopted into the state instead of creating another one.",
+			apiType, adoptedAfter, apiType),
+	}}
+}
+
+// isAmbiguousCreateError reports a failed create that may have been committed
+// anyway, or that collided with the entity an earlier ambiguous create left
+// behind: either way the entity is looked up before giving up.
+func isAmbiguousCreateError(err error) bool {
+	if isAmbiguousRequestError(err) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	return ok && (isAmbiguousCreateStatus(apiErr.Status) || isAlreadyExistsResponse(apiErr))
+}
+
+// adoptAfterAmbiguousCreate looks up the entity a failed create may have
+// produced. lookup returns (nil, nil) when there is no entity under the
+// natural key; conflict returns nil when the entity found matches what was
+// requested, or a *ResourceExistsError explaining the difference otherwise.
+// The entity found is returned along with cause as the failure it was adopted
+// after; when nothing is found the original failure is returned unchanged.
+func adoptAfterAmbiguousCreate[T any](apiType string, cause error, lookup func() (*T, error), conflict func(*T) error) (*T, error, error) {
+	found, err := lookup()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w (looking up a possibly created %s also failed: %v)", cause, apiType, err)
+	}
+	if found == nil {
+		return nil, nil, cause
+	}
+
+	if err := conflict(found); err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("[WARN] %v; adopting the matching %s found under the same name", cause, apiType)
+	return found, cause, nil
+}
+
+// lookupBySlug resolves an entity through one of the slug lookups and decodes
+// it into out. It returns false when no entity has that slug.
+func lookupBySlug(find func() (map[string]interface{}, error), out any) (bool, error) {
+	entity, err := find()
+	if _, ok := IsResourceNotFoundError(err); ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	raw, err := json.Marshal(entity)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(raw, out)
+}
+
+func (c *NullClient) reconcileScopeCreate(ctx context.Context, s *Scope, cause error) (created *Scope, adoptedAfter error, err error) {
+	return adoptAfterAmbiguousCreate("scope", cause, func() (*Scope, error) {
+		found := &Scope{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			return c.GetScopeBySlug(ctx, fmt.Sprint(s.ApplicationId), slugify(s.Name))
+		}, found)
+		if !ok || err != nil || found.Status == "deleted" || found.Status == "deleting" {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Scope) error {
+		if found.Name != s.Name || (s.Type != "" && found.Type != s.Type) {
+			return &ResourceExistsError{"scope", found.Id, fmt.Sprintf("application %d already has a scope with slug %q (name %q, type %q) that does not match the configuration; import it or choose another name", s.ApplicationId, found.Slug, found.Name, found.Type)}
+		}
+		return nil
+	})
+}
+
+func (c *NullClient) reconcileApplicationCreate(ctx context.Context, a *Application, cause error) (created *Application, adoptedAfter error, err error) {
+	slug := a.Slug
+	if slug == "" {
+		slug = slugify(a.Name)
+	}
+
+	return adoptAfterAmbiguousCreate("application", cause, func() (*Application, error) {
+		found := &Application{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			return c.GetApplicationBySlug(ctx, fmt.Sprint(a.NamespaceId), slug)
+		}, found)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Application) error {
+		if found.Name != a.Name || (a.RepositoryUrl != "" && found.RepositoryUrl != a.RepositoryUrl) {
+			return &ResourceExistsError{"application", found.Id, fmt.Sprintf("namespace %d already has an application with slug %q (name %q, repository %q) that does not match the configuration; import it or choose another name", a.NamespaceId, found.Slug, found.Name, found.RepositoryUrl)}
+		}
+		return nil
+	})
+}
+
+func (c *NullClient) reconcileNamespaceCreate(ctx context.Context, n *Namespace, cause error) (created *Namespace, adoptedAfter error, err error) {
+	slug := n.Slug
+	if slug == "" {
+		slug = slugify(n.Name)
+	}
+
+	return adoptAfterAmbiguousCreate("namespace", cause, func() (*Namespace, error) {
+		found := &Namespace{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			return c.GetNamespaceBySlug(ctx, fmt.Sprint(n.AccountId), slug)
+		}, found)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Namespace) error {
+		if found.Name != n.Name {
+			return &ResourceExistsError{"namespace", found.Id, fmt.Sprintf("account %d already has a namespace with slug %q (name %q) that does not match the configuration; import it or choose another name", n.AccountId, found.Slug, found.Name)}
+		}
+		return nil
+	})
+}
+
+func (c *NullClient) reconcileAccountCreate(ctx context.Context, a *Account, cause error) (created *Account, adoptedAfter error, err error) {
+	slug := a.Slug
+	if slug == "" {
+		slug = slugify(a.Name)
+	}
+
+	return adoptAfterAmbiguousCreate("account", cause, func() (*Account, error) {
+		found := &Account{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			return c.GetAccountBySlug(ctx, fmt.Sprint(a.OrganizationId), slug)
+		}, found)
+		if !ok || err != nil {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Account) error {
+		if found.Name != a.Name || (a.RepositoryPrefix != "" && found.RepositoryPrefix != a.RepositoryPrefix) {
+			return &ResourceExistsError{"account", found.Id, fmt.Sprintf("organization %d already has an account with slug %q (name %q) that does not match the configuration; import it or choose another name", a.OrganizationId, found.Slug, found.Name)}
+		}
+		return nil
+	})
+}
+
+func (c *NullClient) reconcileServiceCreate(ctx context.Context, s *Service, cause error) (created *Service, adoptedAfter error, err error) {
+	return adoptAfterAmbiguousCreate("service", cause, func() (*Service, error) {
+		found := &Service{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			path := fmt.Sprintf("%s?entity_nrn=%s&slug=%s", SERVICE_PATH, url.QueryEscape(s.EntityNrn), url.QueryEscape(slugify(s.Name)))
+			return c.getEntityBySlug(ctx, "service", path)
+		}, found)
+		if !ok || err != nil || found.Status == "deleted" || found.Status == "deleting" {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Service) error {
+		if found.Name != s.Name || found.SpecificationId != s.SpecificationId {
+			return &ResourceExistsError{"service", 0, fmt.Sprintf("%s already has a service %s named %q with specification %s, which does not match the configuration; import it or choose another name", s.EntityNrn, found.Id, found.Name, found.SpecificationId)}
+		}
+		return nil
+	})
+}
+
+func (c *NullClient) reconcileLinkCreate(ctx context.Context, l *Link, cause error) (created *Link, adoptedAfter error, err error) {
+	return adoptAfterAmbiguousCreate("link", cause, func() (*Link, error) {
+		found := &Link{}
+		ok, err := lookupBySlug(func() (map[string]interface{}, error) {
+			path := fmt.Sprintf("%s?entity_nrn=%s&service_id=%s&slug=%s", LINK_PATH, url.QueryEscape(l.EntityNrn), url.QueryEscape(l.ServiceId), url.QueryEscape(slugify(l.Name)))
+			return c.getEntityBySlug(ctx, "link", path)
+		}, found)
+		if !ok || err != nil || found.Status == "deleted" || found.Status == "deleting" {
+			return nil, err
+		}
+		return found, nil
+	}, func(found *Link) error {
+		if found.Name != l.Name || found.ServiceId != l.ServiceId || found.SpecificationId != l.SpecificationId {
+			return &ResourceExistsError{"link", 0, fmt.Sprintf("%s already has a link %s named %q to service %s with specification %s, which does not match the configuration; import it or choose another name", l.EntityNrn, found.Id, found.Name, found.ServiceId, found.SpecificationId)}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,257 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"my-api":             "my-api",
+		"My API":             "my-api",
+		"  Payments / Prod ": "payments-prod",
+		"checkout__v2":       "checkout-v2",
+	}
+
+	for name, want := range tests {
+		if got := slugify(name); got != want {
+			t.Errorf("slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestCreateScope_ReconcilesAmbiguousFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		lookup      []Scope
+		wantID      int
+		wantExists  bool
+		wantMessage string
+	}{
+		{
+			name:   "adopts the matching scope the API created",
+			lookup: []Scope{{Id: 11, Name: "Prod", Slug: "prod", ApplicationId: 5, Type: "serverless"}},
+			wantID: 11,
+		},
+		{
+			name:       "reports a conflicting scope under the same slug",
+			lookup:     []Scope{{Id: 12, Name: "Prod", Slug: "prod", ApplicationId: 5, Type: "web_pool"}},
+			wantExists: true,
+		},
+		{
+			name:        "keeps the original error when nothing was created",
+			lookup:      []Scope{},
+			wantMessage: "status=504",
+		},
+		{
+			name:        "ignores a scope that is being deleted",
+			lookup:      []Scope{{Id: 13, Name: "Prod", Slug: "prod", ApplicationId: 5, Type: "serverless", Status: "deleting"}},
+			wantMessage: "status=504",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusGatewayTimeout)
+					return
+				}
+				gotQuery = r.URL.RawQuery
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": tt.lookup})
+			}))
+			defer server.Close()
+
+			c := newTestClient(server)
+			got, _, err := c.CreateScope(context.Background(), &Scope{Name: "Prod", ApplicationId: 5, Type: "serverless"})
+
+			if gotQuery != "application_id=5&slug=prod" {
+				t.Errorf("lookup query = %q, want application_id=5&slug=prod", gotQuery)
+			}
+
+			switch {
+			case tt.wantExists:
+				rErr, ok := IsResourceExistsError(err)
+				if !ok {
+					t.Fatalf("error = %v, want a ResourceExistsError", err)
+				}
+				if rErr.ID != tt.lookup[0].Id {
+					t.Errorf("ResourceExistsError.ID = %d, want %d", rErr.ID, tt.lookup[0].Id)
+				}
+			case tt.wantMessage != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantMessage) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantMessage)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.Id != tt.wantID {
+					t.Errorf("got id %d, want %d", got.Id, tt.wantID)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateService_AdoptsAfterAlreadyExists(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantAdopt bool
+	}{
+		{name: "conflict status", status: http.StatusConflict, body: `{"message":"A service with this name already exists"}`, wantAdopt: true},
+		{name: "conflict code", status: http.StatusBadRequest, body: `{"message":"Duplicated service","code":"ALREADY_EXISTS"}`, wantAdopt: true},
+		{name: "message only", status: http.StatusBadRequest, body: `{"message":"There is already a service with this name"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.body))
+					return
+				}
+				gotQuery = r.URL.Query().Get("entity_nrn") + "|" + r.URL.Query().Get("slug")
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": []Service{
+					{Id: "svc-1", Name: "Orders DB", SpecificationId: "spec-1", EntityNrn: "organization=1:account=2", Status: "active"},
+				}})
+			}))
+			defer server.Close()
+
+			c := newTestClient(server)
+			got, adoptedAfter, err := c.CreateService(context.Background(), &Service{Name: "Orders DB", SpecificationId: "spec-1", EntityNrn: "organization=1:account=2"})
+			if !tt.wantAdopt {
+				if err == nil || gotQuery != "" {
+					t.Fatalf("err = %v and lookup = %q, want the error without a lookup", err, gotQuery)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Id != "svc-1" || adoptedAfter == nil {
+				t.Errorf("got id %q adopted after %v, want svc-1 adopted", got.Id, adoptedAfter)
+			}
+			if gotQuery != "organization=1:account=2|orders-db" {
+				t.Errorf("lookup = %q, want organization=1:account=2|orders-db", gotQuery)
+			}
+		})
+	}
+}
+
+func TestCreateScope_DoesNotAdoptOnDuplicateNameMessage(t *testing.T) {
+	var lookedUp bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"` + DUPLICATE_SCOPE_NAME_ERROR_STR + `"}`))
+			return
+		}
+		lookedUp = true
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if _, _, err := c.CreateScope(context.Background(), &Scope{Name: "production", ApplicationId: 3}); err == nil || lookedUp {
+		t.Fatalf("err = %v and looked up = %v, want the error without a lookup", err, lookedUp)
+	}
+}
+
+func TestNamespaceCreate_WarnsWhenAdopted(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message":"Namespace already exists"}`))
+		case r.URL.Path == NAMESPACE_PATH:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": []Namespace{
+				{Id: 9, Name: "Payments", Slug: "payments", AccountId: 2},
+			}})
+		default:
+			_ = json.NewEncoder(w).Encode(Namespace{Id: 9, Name: "Payments", Slug: "payments", AccountId: 2, Status: "active"})
+		}
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceNamespace().Schema, map[string]interface{}{
+		"name":       "Payments",
+		"account_id": 2,
+	})
+	diags := NamespaceCreate(context.Background(), d, newTestClient(server))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "9" {
+		t.Errorf("id = %q, want 9", d.Id())
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "Existing Namespace Adopted" {
+		t.Errorf("diags = %v, want the adoption warning", diags)
+	}
+}
+
+func TestCreateLink_DoesNotReconcileValidationErrors(t *testing.T) {
+	var lookups int
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			lookups++
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"attributes are invalid"}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	if _, _, err := c.CreateLink(context.Background(), &Link{Name: "db", ServiceId: "svc-1"}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if lookups != 0 {
+		t.Errorf("lookups = %d, want none for a plain validation error", lookups)
+	}
+}
+
+func TestCreateApplication_ReconcilesClientTimeout(t *testing.T) {
+	created := make(chan struct{}, 1)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			created <- struct{}{}
+			time.Sleep(200 * time.Millisecond)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": []Application{
+			{Id: 7, Name: "my-api", NamespaceId: 42, Slug: "my-api"},
+		}})
+	}))
+	defer server.Close()
+
+	c := newTestClient(server)
+	c.Client.Timeout = 50 * time.Millisecond
+
+	got, adoptedAfter, err := c.CreateApplication(context.Background(), &Application{Name: "my-api", NamespaceId: 42})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Id != 7 || adoptedAfter == nil {
+		t.Errorf("got id %d adopted after %v, want 7 adopted", got.Id, adoptedAfter)
+	}
+	if len(created) != 1 {
+		t.Errorf("the create must be sent exactly once")
+	}
+}
@@ -73,7 +73,7 @@ func dataSourceActionSpecification() *schema.Resource {
 	}
 }
 
-func dataSourceActionSpecificationRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceActionSpecificationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var parentType, parentId string
@@ -85,56 +85,56 @@ func dataSourceActionSpecificationRead(_ context.Context, d *schema.ResourceData
 		parentId = d.Get("link_specification_id").(string)
 	}
 
-	spec, err := nullOps.GetActionSpecification(d.Get("id").(string), parentType, parentId)
+	spec, err := nullOps.GetActionSpecification(ctx, d.Get("id").(string), parentType, parentId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", spec.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("service_specification_id", spec.ServiceSpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("link_specification_id", spec.LinkSpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("retryable", spec.Retryable); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("icon", spec.Icon); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	parametersJSON, err := json.Marshal(spec.Parameters)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
 	}
 	if err := d.Set("parameters", string(parametersJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resultsJSON, err := json.Marshal(spec.Results)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing results to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing results to JSON: %v", err))
 	}
 	if err := d.Set("results", string(resultsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if spec.Annotations != nil {
 		annotationsJSON, err := json.Marshal(spec.Annotations)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing annotations to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing annotations to JSON: %v", err))
 		}
 		if err := d.Set("annotations", string(annotationsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
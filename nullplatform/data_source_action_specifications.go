@@ -68,30 +68,30 @@ func dataSourceActionSpecifications() *schema.Resource {
 	}
 }
 
-func dataSourceActionSpecificationsRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceActionSpecificationsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	serviceSpecId := d.Get("service_specification_id").(string)
 
-	specs, err := nullOps.ListActionSpecifications(serviceSpecId)
+	specs, err := nullOps.ListActionSpecifications(ctx, serviceSpecId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	actionSpecs := make([]map[string]interface{}, 0, len(specs))
 	for _, spec := range specs {
 		parametersJSON, err := json.Marshal(spec.Parameters)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing parameters for %s: %v", spec.Id, err))
+			return diagFromErr(fmt.Errorf("error serializing parameters for %s: %v", spec.Id, err))
 		}
 
 		resultsJSON, err := json.Marshal(spec.Results)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing results for %s: %v", spec.Id, err))
+			return diagFromErr(fmt.Errorf("error serializing results for %s: %v", spec.Id, err))
 		}
 
 		annotationsJSON, err := json.Marshal(spec.Annotations)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing annotations for %s: %v", spec.Id, err))
+			return diagFromErr(fmt.Errorf("error serializing annotations for %s: %v", spec.Id, err))
 		}
 
 		actionSpecs = append(actionSpecs, map[string]interface{}{
@@ -108,7 +108,7 @@ func dataSourceActionSpecificationsRead(_ context.Context, d *schema.ResourceDat
 	}
 
 	if err := d.Set("action_specifications", actionSpecs); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(serviceSpecId)
@@ -90,92 +90,92 @@ func dataSourceApplication() *schema.Resource {
 	}
 }
 
-func dataSourceApplicationRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceApplicationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	app, err := nullOps.GetApplication(strconv.Itoa(d.Get("id").(int)))
+	app, err := nullOps.GetApplication(ctx, strconv.Itoa(d.Get("id").(int)))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("name", app.Name)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("status", app.Status)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("namespace_id", app.NamespaceId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("repository_url", app.RepositoryUrl)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("slug", app.Slug)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("template_id", app.TemplateId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("auto_deploy_on_creation", app.AutoDeployOnCreation)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("repository_app_path", app.RepositoryAppPath)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("is_mono_repo", app.IsMonoRepo)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if app.Tags != nil {
 		tagsJSON, jerr := json.Marshal(app.Tags)
 		if jerr != nil {
-			return diag.FromErr(jerr)
+			return diagFromErr(jerr)
 		}
 		if err = d.Set("tags", string(tagsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if app.Settings != nil {
 		settingsJSON, jerr := json.Marshal(app.Settings)
 		if jerr != nil {
-			return diag.FromErr(jerr)
+			return diagFromErr(jerr)
 		}
 		if err = d.Set("settings", string(settingsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if app.Messages != nil {
 		messagesJSON, jerr := json.Marshal(app.Messages)
 		if jerr != nil {
-			return diag.FromErr(jerr)
+			return diagFromErr(jerr)
 		}
 		if err = d.Set("messages", string(messagesJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	err = d.Set("nrn", app.Nrn)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	//fmt.Printf("ResourceData: %+v\n", d)
@@ -35,9 +35,10 @@ func dataSourceDimension() *schema.Resource {
 				Description: "Possible values: [`active`, `inactive`].",
 			},
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "A system-wide unique ID representing the resource. If id not provided nrn is mandatory",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "A system-wide unique ID representing the resource. If id not provided nrn is mandatory",
+				ValidateDiagFunc: validateNRN,
 			},
 			"values": {
 				Type:        schema.TypeList,
@@ -71,7 +72,7 @@ func dataSourceDimension() *schema.Resource {
 		},
 	}
 }
-func dataSourceDimensionRead(_ context.Context, d *schema.ResourceData, unWrappedNullOps any) diag.Diagnostics {
+func dataSourceDimensionRead(ctx context.Context, d *schema.ResourceData, unWrappedNullOps any) diag.Diagnostics {
 	nullOps := unWrappedNullOps.(NullOps)
 
 	id := strconv.Itoa(d.Get("id").(int))
@@ -79,25 +80,25 @@ func dataSourceDimensionRead(_ context.Context, d *schema.ResourceData, unWrappe
 	slug := d.Get("slug").(string)
 	status := d.Get("status").(string)
 	nrn := d.Get("nrn").(string)
-	dimension, err := nullOps.GetDimension(&id, &name, &slug, &status, &nrn)
+	dimension, err := nullOps.GetDimension(ctx, &id, &name, &slug, &status, &nrn)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("name", dimension.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("status", dimension.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("slug", dimension.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err = d.Set("nrn", dimension.NRN); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	value := make([]map[string]any, len(dimension.Values))
@@ -112,7 +113,7 @@ func dataSourceDimensionRead(_ context.Context, d *schema.ResourceData, unWrappe
 	}
 
 	if err = d.Set("values", value); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.FormatInt(int64(dimension.ID), 10))
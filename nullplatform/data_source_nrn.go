@@ -0,0 +1,296 @@
+package nullplatform
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// nrnEntity is what the nullplatform_nrn data source reports for each level.
+type nrnEntity struct {
+	ID   string
+	Slug string
+	Name string
+}
+
+// nrnEntityLevels are the levels below the organization, with how to look an
+// entity up by ID and by slug under its parent.
+var nrnEntityLevels = []struct {
+	level  string
+	byID   func(ctx context.Context, nullOps NullOps, id string) (*nrnEntity, error)
+	bySlug func(ctx context.Context, nullOps NullOps, parentID, slug string) (map[string]interface{}, error)
+}{
+	{
+		level: "account",
+		byID: func(ctx context.Context, nullOps NullOps, id string) (*nrnEntity, error) {
+			a, err := nullOps.GetAccount(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &nrnEntity{ID: id, Slug: a.Slug, Name: a.Name}, nil
+		},
+		bySlug: func(ctx context.Context, nullOps NullOps, parentID, slug string) (map[string]interface{}, error) {
+			return nullOps.GetAccountBySlug(ctx, parentID, slug)
+		},
+	},
+	{
+		level: "namespace",
+		byID: func(ctx context.Context, nullOps NullOps, id string) (*nrnEntity, error) {
+			n, err := nullOps.GetNamespace(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &nrnEntity{ID: id, Slug: n.Slug, Name: n.Name}, nil
+		},
+		bySlug: func(ctx context.Context, nullOps NullOps, parentID, slug string) (map[string]interface{}, error) {
+			return nullOps.GetNamespaceBySlug(ctx, parentID, slug)
+		},
+	},
+	{
+		level: "application",
+		byID: func(ctx context.Context, nullOps NullOps, id string) (*nrnEntity, error) {
+			a, err := nullOps.GetApplication(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &nrnEntity{ID: id, Slug: a.Slug, Name: a.Name}, nil
+		},
+		bySlug: func(ctx context.Context, nullOps NullOps, parentID, slug string) (map[string]interface{}, error) {
+			return nullOps.GetApplicationBySlug(ctx, parentID, slug)
+		},
+	},
+	{
+		level: "scope",
+		byID: func(ctx context.Context, nullOps NullOps, id string) (*nrnEntity, error) {
+			s, err := nullOps.GetScope(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return &nrnEntity{ID: id, Slug: s.Slug, Name: s.Name}, nil
+		},
+		bySlug: func(ctx context.Context, nullOps NullOps, parentID, slug string) (map[string]interface{}, error) {
+			return nullOps.GetScopeBySlug(ctx, parentID, slug)
+		},
+	},
+}
+
+func dataSourceNRN() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"nrn": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "The NRN to resolve, such as `organization=1:account=2:namespace=3`. Computed when the entity is given by its slugs.",
+			ValidateDiagFunc: validateNRN,
+			ConflictsWith:    []string{"path", "account", "namespace", "application", "scope"},
+		},
+		"path": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Description:   "The slugs from the account down, separated by `/`, such as `acme/payments/checkout/prod`. Computed when the entity is given by its NRN or slug attributes.",
+			ConflictsWith: []string{"nrn", "account", "namespace", "application", "scope"},
+		},
+		"organization_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the organization.",
+		},
+	}
+	for _, l := range nrnEntityLevels {
+		s[l.level] = &schema.Schema{
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			Description:   fmt.Sprintf("The slug of the %s. Computed when the entity is given by its NRN or path.", l.level),
+			ConflictsWith: []string{"nrn", "path"},
+		}
+		s[l.level+"_id"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s, `0` when the NRN does not reach it.", l.level),
+		}
+		s[l.level+"_name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s.", l.level),
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Resolves an NRN to the IDs, slugs and names of every level it goes through, or the slugs " +
+			"of an account, namespace, application and scope to their NRN and IDs. Exactly one of `nrn`, " +
+			"`path` or the slug attributes must be set.",
+		ReadContext: dataSourceNRNRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceNRNRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	var organizationID string
+	var entities []*nrnEntity
+	var err error
+
+	if v, ok := d.GetOk("nrn"); ok {
+		organizationID, entities, err = resolveNRNByID(ctx, nullOps, v.(string))
+	} else {
+		var slugs []string
+		slugs, err = nrnSlugs(d)
+		if err == nil {
+			organizationID, entities, err = resolveNRNBySlug(ctx, nullOps, slugs)
+		}
+	}
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	nrnParts := []string{"organization=" + organizationID}
+	var slugs []string
+	for i, entity := range entities {
+		nrnParts = append(nrnParts, fmt.Sprintf("%s=%s", nrnEntityLevels[i].level, entity.ID))
+		slugs = append(slugs, entity.Slug)
+	}
+	nrn := strings.Join(nrnParts, ":")
+
+	d.SetId(nrn)
+	if err := d.Set("nrn", nrn); err != nil {
+		return diagFromErr(err)
+	}
+	if err := d.Set("path", strings.Join(slugs, "/")); err != nil {
+		return diagFromErr(err)
+	}
+	if err := d.Set("organization_id", atoiOrZero(organizationID)); err != nil {
+		return diagFromErr(err)
+	}
+	for i, l := range nrnEntityLevels {
+		entity := &nrnEntity{}
+		if i < len(entities) {
+			entity = entities[i]
+		}
+		if err := d.Set(l.level, entity.Slug); err != nil {
+			return diagFromErr(err)
+		}
+		if err := d.Set(l.level+"_id", atoiOrZero(entity.ID)); err != nil {
+			return diagFromErr(err)
+		}
+		if err := d.Set(l.level+"_name", entity.Name); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// nrnSlugs returns the slugs the data source was given, from the account down.
+func nrnSlugs(d *schema.ResourceData) ([]string, error) {
+	if v, ok := d.GetOk("path"); ok {
+		slugs := strings.Split(strings.Trim(v.(string), "/"), "/")
+		if len(slugs) > len(nrnEntityLevels) {
+			return nil, fmt.Errorf("path %q has %d slugs, at most %d (account, namespace, application and scope) are allowed", v, len(slugs), len(nrnEntityLevels))
+		}
+		for _, slug := range slugs {
+			if slug == "" {
+				return nil, fmt.Errorf("path %q has an empty slug", v)
+			}
+		}
+		return slugs, nil
+	}
+
+	var slugs []string
+	for i, l := range nrnEntityLevels {
+		v, ok := d.GetOk(l.level)
+		if !ok {
+			for _, below := range nrnEntityLevels[i+1:] {
+				if _, ok := d.GetOk(below.level); ok {
+					return nil, fmt.Errorf("%s is set but %s is not; the slugs of every level above it are needed", below.level, l.level)
+				}
+			}
+			break
+		}
+		slugs = append(slugs, v.(string))
+	}
+	if len(slugs) == 0 {
+		return nil, fmt.Errorf("one of nrn, path or account must be set")
+	}
+	return slugs, nil
+}
+
+func resolveNRNByID(ctx context.Context, nullOps NullOps, s string) (string, []*nrnEntity, error) {
+	nrn, err := ParseNRN(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var entities []*nrnEntity
+	for i, c := range nrn[1:] {
+		l := nrnEntityLevels[i]
+		if c.Level != l.level {
+			return "", nil, fmt.Errorf("NRN %s skips the %s level, which cannot be resolved", s, l.level)
+		}
+		entity, err := l.byID(ctx, nullOps, c.ID)
+		if err != nil {
+			return "", nil, fmt.Errorf("error resolving %s %s: %w", c.Level, c.ID, err)
+		}
+		entities = append(entities, entity)
+	}
+
+	return nrn.ID("organization"), entities, nil
+}
+
+func resolveNRNBySlug(ctx context.Context, nullOps NullOps, slugs []string) (string, []*nrnEntity, error) {
+	organizationID, err := nullOps.GetOrganizationID(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting organization ID: %w", err)
+	}
+
+	var entities []*nrnEntity
+	parentID := organizationID
+	for i, slug := range slugs {
+		l := nrnEntityLevels[i]
+		result, err := l.bySlug(ctx, nullOps, parentID, slug)
+		if err != nil {
+			return "", nil, fmt.Errorf("error resolving %s %q: %w", l.level, slug, err)
+		}
+
+		id, err := slugLookupID(l.level, result)
+		if err != nil {
+			return "", nil, err
+		}
+		name, _ := result["name"].(string)
+
+		entities = append(entities, &nrnEntity{ID: id, Slug: slug, Name: name})
+		parentID = id
+	}
+
+	return organizationID, entities, nil
+}
+
+// slugLookupID returns the ID of an entity found by one of the
+// Get*BySlug lookups.
+func slugLookupID(level string, result map[string]interface{}) (string, error) {
+	var id string
+	switch idVal := result["id"].(type) {
+	case string:
+		id = idVal
+	case float64:
+		id = fmt.Sprintf("%.0f", idVal)
+	default:
+		return "", fmt.Errorf("%s has invalid ID type: %T. Full result: %v", level, result["id"], result)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("%s has empty ID. Full result: %v", level, result)
+	}
+	return id, nil
+}
+
+func atoiOrZero(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
@@ -0,0 +1,74 @@
+package nullplatform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNRNNamespace() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads keys of one namespace stored on an NRN. The API cannot list a namespace, so the keys to read must be given.",
+
+		ReadContext: dataSourceNRNNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"nrn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The NRN the keys are stored on.",
+				ValidateDiagFunc: validateNRN,
+			},
+			"namespace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The namespace of the keys, such as `aws`, `k8s` or `global`.",
+				ValidateDiagFunc: validateNRNNamespaceName,
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the namespace to read.",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The value of each key that is set. Values that are not strings are JSON encoded.",
+			},
+		},
+	}
+}
+
+func dataSourceNRNNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	nrn := d.Get("nrn").(string)
+	namespace := d.Get("namespace").(string)
+
+	var keys []string
+	for _, key := range d.Get("keys").([]interface{}) {
+		keys = append(keys, key.(string))
+	}
+
+	n, err := nullOps.GetNRN(ctx, nrn, nrnNamespaceKeys(namespace, keys))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	values := map[string]string{}
+	for _, key := range keys {
+		if v, ok := n.Namespaces[namespace][key]; ok && v != nil {
+			values[key] = n.Value(namespace, key)
+		}
+	}
+
+	d.SetId(nrnNamespaceID(nrn, namespace))
+	if err := d.Set("values", values); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newNRNTestServer serves organization 1 > account 2 (acme) > namespace 3
+// (payments) > application 4 (checkout) > scope 5 (prod), by ID and by slug.
+func newNRNTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	type entity struct {
+		path, parentParam, parentID, slug, name string
+		id                                      int
+	}
+	entities := []entity{
+		{"/account", "organization_id", "1", "acme", "Acme", 2},
+		{"/namespace", "account_id", "2", "payments", "Payments", 3},
+		{"/application", "namespace_id", "3", "checkout", "Checkout", 4},
+		{"/scope", "application_id", "4", "prod", "Production", 5},
+	}
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, e := range entities {
+			body := map[string]interface{}{"id": e.id, "slug": e.slug, "name": e.name, "status": "active"}
+			switch {
+			case r.URL.Path == e.path+"/"+strconv.Itoa(e.id):
+				json.NewEncoder(w).Encode(body)
+				return
+			case r.URL.Path == e.path && r.URL.Query().Get("slug") != "":
+				results := []map[string]interface{}{}
+				if r.URL.Query().Get(e.parentParam) == e.parentID && r.URL.Query().Get("slug") == e.slug {
+					results = append(results, body)
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+}
+
+func TestDataSourceNRNRead(t *testing.T) {
+	server := newNRNTestServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:   "from nrn",
+			config: map[string]interface{}{"nrn": "organization=1:account=2:namespace=3"},
+			want: map[string]interface{}{
+				"nrn": "organization=1:account=2:namespace=3", "path": "acme/payments",
+				"organization_id": 1, "account_id": 2, "namespace_id": 3, "application_id": 0,
+				"account": "acme", "namespace": "payments", "application": "",
+				"account_name": "Acme", "namespace_name": "Payments",
+			},
+		},
+		{
+			name:   "from path",
+			config: map[string]interface{}{"path": "acme/payments/checkout/prod"},
+			want: map[string]interface{}{
+				"nrn":             "organization=1:account=2:namespace=3:application=4:scope=5",
+				"organization_id": 1, "application_id": 4, "scope_id": 5,
+				"application": "checkout", "scope": "prod", "scope_name": "Production",
+			},
+		},
+		{
+			name:   "from slugs",
+			config: map[string]interface{}{"account": "acme", "namespace": "payments", "application": "checkout"},
+			want: map[string]interface{}{
+				"nrn": "organization=1:account=2:namespace=3:application=4", "path": "acme/payments/checkout",
+				"application_id": 4, "application_name": "Checkout", "scope_id": 0,
+			},
+		},
+		{
+			name:    "missing level",
+			config:  map[string]interface{}{"account": "acme", "application": "checkout"},
+			wantErr: "application is set but namespace is not",
+		},
+		{
+			name:    "unknown slug",
+			config:  map[string]interface{}{"path": "acme/billing"},
+			wantErr: `error resolving namespace "billing"`,
+		},
+		{
+			name:    "skipped level",
+			config:  map[string]interface{}{"nrn": "organization=1:namespace=3"},
+			wantErr: "skips the account level",
+		},
+		{
+			name:    "nothing set",
+			config:  map[string]interface{}{},
+			wantErr: "one of nrn, path or account must be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(server)
+			client.OrganizationID = "1"
+
+			d := schema.TestResourceDataRaw(t, dataSourceNRN().Schema, tt.config)
+			diags := dataSourceNRNRead(context.Background(), d, client)
+
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tt.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tt.wantErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			for key, want := range tt.want {
+				if got := d.Get(key); got != want {
+					t.Errorf("%s = %v, want %v", key, got, want)
+				}
+			}
+			if d.Id() != d.Get("nrn") {
+				t.Errorf("id = %q, want the nrn", d.Id())
+			}
+		})
+	}
+}
@@ -17,9 +17,10 @@ func dataSourcePackage() *schema.Resource {
 		ReadContext: dataSourcePackageRead,
 		Schema: map[string]*schema.Schema{
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The owner NRN of the package.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The owner NRN of the package.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"slug": {
 				Type:        schema.TypeString,
@@ -71,15 +72,15 @@ func dataSourcePackage() *schema.Resource {
 	}
 }
 
-func dataSourcePackageRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourcePackageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	nrn := d.Get("nrn").(string)
 	slug := d.Get("slug").(string)
 
-	pkg, err := nullOps.FindPackage(nrn, slug)
+	pkg, err := nullOps.FindPackage(ctx, nrn, slug)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	revisionID := pkg.DefaultRevisionID
@@ -88,9 +89,9 @@ func dataSourcePackageRead(_ context.Context, d *schema.ResourceData, m interfac
 	}
 
 	if version, ok := d.GetOk("version"); ok {
-		revisions, err := nullOps.ListPackageRevisions(pkg.ID)
+		revisions, err := nullOps.ListPackageRevisions(ctx, pkg.ID)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		revisionID = ""
 		for _, revision := range revisions {
@@ -100,31 +101,31 @@ func dataSourcePackageRead(_ context.Context, d *schema.ResourceData, m interfac
 			}
 		}
 		if revisionID == "" {
-			return diag.FromErr(fmt.Errorf("package %s/%s has no published version %s", nrn, slug, version))
+			return diagFromErr(fmt.Errorf("package %s/%s has no published version %s", nrn, slug, version))
 		}
 	}
 
 	d.SetId(pkg.ID)
 	if err := d.Set("name", pkg.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", pkg.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("revision_id", revisionID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("default_revision_id", pkg.DefaultRevisionID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("latest_revision_id", pkg.LatestRevisionID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("default_version", pkg.DefaultVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("latest_version", pkg.LatestVersion); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
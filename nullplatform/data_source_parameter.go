@@ -72,52 +72,52 @@ func dataSourceParameter() *schema.Resource {
 	}
 }
 
-func dataSourceParameterRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceParameterRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	param, err := nullOps.GetParameter(strconv.Itoa(d.Get("id").(int)), nil)
+	param, err := nullOps.GetParameter(ctx, strconv.Itoa(d.Get("id").(int)), nil)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("name", param.Name)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("nrn", param.Nrn)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("type", param.Type)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("encoding", param.Encoding)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("variable", param.Variable)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("destination_path", param.DestinationPath)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("secret", param.Secret)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("read_only", param.ReadOnly)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(param.Id))
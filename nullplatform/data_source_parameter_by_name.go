@@ -24,9 +24,10 @@ func dataSourceParameterByName() *schema.Resource {
 				Description: "Definition name of the variable.",
 			},
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The NRN of the application to which the parameter belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The NRN of the application to which the parameter belongs to.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -72,12 +73,12 @@ func dataSourceParameterByName() *schema.Resource {
 	}
 }
 
-func dataSourceParameterByNameRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceParameterByNameRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	parameterList, err := nullOps.GetParameterList(d.Get("nrn").(string))
+	parameterList, err := nullOps.GetParameterList(ctx, d.Get("nrn").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	param := &Parameter{
@@ -89,42 +90,42 @@ func dataSourceParameterByNameRead(_ context.Context, d *schema.ResourceData, m
 	if paramExists {
 		err = d.Set("name", paramRes.Name)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("nrn", paramRes.Nrn)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("type", paramRes.Type)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("encoding", paramRes.Encoding)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("variable", paramRes.Variable)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("destination_path", paramRes.DestinationPath)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("secret", paramRes.Secret)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = d.Set("read_only", paramRes.ReadOnly)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		d.SetId(strconv.Itoa(paramRes.Id))
@@ -20,9 +20,10 @@ func dataSourcePlatformArtifact() *schema.Resource {
 		ReadContext: dataSourcePlatformArtifactRead,
 		Schema: map[string]*schema.Schema{
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The owner NRN the artifact is registered under.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The owner NRN the artifact is registered under.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -94,7 +95,7 @@ func metaMatches(wanted, actual map[string]interface{}) bool {
 	return true
 }
 
-func dataSourcePlatformArtifactRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func dataSourcePlatformArtifactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	nrn := d.Get("nrn").(string)
@@ -102,12 +103,12 @@ func dataSourcePlatformArtifactRead(_ context.Context, d *schema.ResourceData, m
 
 	var wantedMeta map[string]interface{}
 	if err := json.Unmarshal([]byte(d.Get("meta").(string)), &wantedMeta); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing meta JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing meta JSON: %v", err))
 	}
 
-	artifacts, err := nullOps.ListPlatformArtifacts(nrn, artifactType)
+	artifacts, err := nullOps.ListPlatformArtifacts(ctx, nrn, artifactType)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Identity match: the artifact whose identity_meta is a subset of the
@@ -119,16 +120,16 @@ func dataSourcePlatformArtifactRead(_ context.Context, d *schema.ResourceData, m
 		}
 	}
 	if len(matched) == 0 {
-		return diag.FromErr(fmt.Errorf("no %s artifact under %s matches meta %v", artifactType, nrn, wantedMeta))
+		return diagFromErr(fmt.Errorf("no %s artifact under %s matches meta %v", artifactType, nrn, wantedMeta))
 	}
 	if len(matched) > 1 {
-		return diag.FromErr(fmt.Errorf("meta %v matches %d %s artifacts under %s; add identity fields to disambiguate", wantedMeta, len(matched), artifactType, nrn))
+		return diagFromErr(fmt.Errorf("meta %v matches %d %s artifacts under %s; add identity fields to disambiguate", wantedMeta, len(matched), artifactType, nrn))
 	}
 	artifact := matched[0]
 
-	revisions, err := nullOps.ListPlatformArtifactRevisions(artifact.ResourceID)
+	revisions, err := nullOps.ListPlatformArtifactRevisions(ctx, artifact.ResourceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Revision match: newest revision whose meta carries every requested
@@ -142,29 +143,29 @@ func dataSourcePlatformArtifactRead(_ context.Context, d *schema.ResourceData, m
 		}
 	}
 	if revision == nil {
-		return diag.FromErr(fmt.Errorf("artifact %s has no revision matching meta %v", artifact.ResourceID, wantedMeta))
+		return diagFromErr(fmt.Errorf("artifact %s has no revision matching meta %v", artifact.ResourceID, wantedMeta))
 	}
 
 	revisionMetaJSON, err := json.Marshal(revision.Meta)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing revision meta to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing revision meta to JSON: %v", err))
 	}
 
 	d.SetId(revision.ResourceRevisionID)
 	if err := d.Set("artifact_id", artifact.ResourceID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("revision_id", revision.ResourceRevisionID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("revision_meta", string(revisionMetaJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", artifact.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("latest_revision_id", artifact.LatestRevisionID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
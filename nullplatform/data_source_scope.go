@@ -50,39 +50,39 @@ func dataSourceScope() *schema.Resource {
 	}
 }
 
-func dataSourceScopeRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceScopeRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	log.Print("\n\n--- Terraform 'read data source Scope' operation begin ---\n\n")
 
-	s, err := nullOps.GetScope(d.Get("id").(string))
+	s, err := nullOps.GetScope(ctx, d.Get("id").(string))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("name", s.Name)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("nrn", s.Nrn)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("dimensions", s.Dimensions)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("runtime_configurations", s.RuntimeConfigurations)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	//fmt.Printf("ResourceData: %+v\n", d)
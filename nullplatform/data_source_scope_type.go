@@ -57,34 +57,34 @@ func dataSourceScopeType() *schema.Resource {
 	}
 }
 
-func dataSourceScopeTypeRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceScopeTypeRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	st, err := nullOps.GetScopeType(d.Get("id").(string))
+	st, err := nullOps.GetScopeType(ctx, d.Get("id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("nrn", st.Nrn); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", st.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("name", st.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", st.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", st.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("provider_type", st.ProviderType); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("provider_id", st.ProviderId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", st.Id))
@@ -26,19 +26,19 @@ func dataSourceService() *schema.Resource {
 	}
 }
 
-func dataSourceServiceRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceServiceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	s, err := nullOps.GetService(d.Get("id").(string))
+	s, err := nullOps.GetService(ctx, d.Get("id").(string))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = d.Set("name", s.Name)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(d.Get("id").(string))
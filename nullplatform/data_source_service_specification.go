@@ -99,62 +99,62 @@ func dataSourceServiceSpecification() *schema.Resource {
 	}
 }
 
-func dataSourceServiceSpecificationRead(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func dataSourceServiceSpecificationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	spec, err := nullOps.GetServiceSpecification(d.Get("id").(string))
+	spec, err := nullOps.GetServiceSpecification(ctx, d.Get("id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", spec.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if spec.UseDefaultActions != nil {
 		if err := d.Set("use_default_actions", *spec.UseDefaultActions); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if spec.UseDefaultNaming != nil {
 		if err := d.Set("use_default_naming", *spec.UseDefaultNaming); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if err := d.Set("assignable_to", spec.AssignableTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", spec.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	dimensionsJSON, err := json.Marshal(spec.Dimensions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
 	}
 	if err := d.Set("dimensions", string(dimensionsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	attributesJSON, err := json.Marshal(spec.Attributes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
 	}
 	if err := d.Set("attributes", string(attributesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	scopesJSON, err := json.Marshal(spec.Scopes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
 	}
 	if err := d.Set("scopes", string(scopesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if spec.Selectors != nil {
@@ -167,7 +167,7 @@ func dataSourceServiceSpecificationRead(_ context.Context, d *schema.ResourceDat
 			},
 		}
 		if err := d.Set("selectors", selectors); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
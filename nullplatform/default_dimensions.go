@@ -0,0 +1,101 @@
+package nullplatform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources that send dimensions merge the provider's default_dimensions
+// under their own, the way default_tags work in other providers:
+//
+//   - dimensions holds what the configuration sets on the resource;
+//   - dimensions_all holds what is actually sent, the defaults overridden by
+//     the resource's keys, and is planned by dimensionsAllCustomizeDiff so a
+//     change to the defaults shows up in the plan.
+//
+// On read, keys inherited from the defaults are kept out of dimensions so the
+// configuration never sees a diff for them.
+
+// dimensionsAllSchema is the computed dimensions_all attribute. forceNew must
+// match the resource's dimensions attribute, so a change to the defaults
+// replaces resources whose dimensions cannot be updated in place.
+func dimensionsAllSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		ForceNew:    forceNew,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The dimensions sent to nullplatform: the provider's `default_dimensions` merged with `dimensions`, whose keys take precedence.",
+	}
+}
+
+// defaultDimensions returns the provider's default_dimensions.
+func defaultDimensions(m interface{}) map[string]string {
+	if client, ok := m.(*NullClient); ok {
+		return client.DefaultDimensions
+	}
+	return nil
+}
+
+// dimensionsWithDefaults returns the resource's dimensions merged over the
+// provider's default_dimensions. d is a *schema.ResourceData or a
+// *schema.ResourceDiff.
+func dimensionsWithDefaults(d interface{ Get(string) interface{} }, m interface{}) map[string]string {
+	dimensions := map[string]string{}
+	for key, value := range defaultDimensions(m) {
+		dimensions[key] = value
+	}
+	for key, value := range d.Get("dimensions").(map[string]interface{}) {
+		dimensions[key] = value.(string)
+	}
+	return dimensions
+}
+
+// setDimensions stores the dimensions read from the API: all of them in
+// dimensions_all, and in dimensions only those that were configured on the
+// resource or do not come from default_dimensions.
+func setDimensions(d *schema.ResourceData, m interface{}, dimensions map[string]string) error {
+	defaults := defaultDimensions(m)
+	configured := d.Get("dimensions").(map[string]interface{})
+
+	own := make(map[string]string, len(dimensions))
+	for key, value := range dimensions {
+		_, isConfigured := configured[key]
+		if defaultValue, isDefault := defaults[key]; isDefault && defaultValue == value && !isConfigured {
+			continue
+		}
+		own[key] = value
+	}
+
+	if err := d.Set("dimensions", own); err != nil {
+		return err
+	}
+	return d.Set("dimensions_all", dimensions)
+}
+
+// dimensionsAllCustomizeDiff plans dimensions_all from the configured
+// dimensions and the provider's default_dimensions.
+func dimensionsAllCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("dimensions") {
+		return d.SetNewComputed("dimensions_all")
+	}
+
+	dimensions := dimensionsWithDefaults(d, m)
+
+	old := d.Get("dimensions_all").(map[string]interface{})
+	if d.Id() != "" && len(old) == len(dimensions) {
+		unchanged := true
+		for key, value := range dimensions {
+			if old[key] != value {
+				unchanged = false
+				break
+			}
+		}
+		if unchanged {
+			return nil
+		}
+	}
+
+	return d.SetNew("dimensions_all", dimensions)
+}
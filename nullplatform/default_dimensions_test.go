@@ -0,0 +1,164 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDimensionsWithDefaults(t *testing.T) {
+	client := &NullClient{DefaultDimensions: map[string]string{"environment": "prod", "country": "ar"}}
+	d := schema.TestResourceDataRaw(t, resourceRuntimeConfiguration().Schema, map[string]interface{}{
+		"dimensions": map[string]interface{}{"environment": "dev", "team": "payments"},
+	})
+
+	got := dimensionsWithDefaults(d, client)
+	want := map[string]string{"environment": "dev", "country": "ar", "team": "payments"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dimensionsWithDefaults() = %v, want %v", got, want)
+	}
+}
+
+func TestSetDimensions(t *testing.T) {
+	client := &NullClient{DefaultDimensions: map[string]string{"environment": "prod", "country": "ar"}}
+	d := schema.TestResourceDataRaw(t, resourceRuntimeConfiguration().Schema, map[string]interface{}{
+		"dimensions": map[string]interface{}{"country": "ar"},
+	})
+
+	apiDimensions := map[string]string{"environment": "prod", "country": "ar", "team": "payments"}
+	if err := setDimensions(d, client, apiDimensions); err != nil {
+		t.Fatal(err)
+	}
+
+	// environment is inherited, country is configured even though it matches
+	// the default and team does not come from the defaults.
+	wantOwn := map[string]interface{}{"country": "ar", "team": "payments"}
+	if got := d.Get("dimensions").(map[string]interface{}); !reflect.DeepEqual(got, wantOwn) {
+		t.Errorf("dimensions = %v, want %v", got, wantOwn)
+	}
+	wantAll := map[string]interface{}{"environment": "prod", "country": "ar", "team": "payments"}
+	if got := d.Get("dimensions_all").(map[string]interface{}); !reflect.DeepEqual(got, wantAll) {
+		t.Errorf("dimensions_all = %v, want %v", got, wantAll)
+	}
+}
+
+func TestDimensionsAllCustomizeDiff(t *testing.T) {
+	r := resourceRuntimeConfiguration()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"nrn":        "organization=1",
+		"dimensions": map[string]interface{}{"environment": "dev"},
+	})
+	state := &terraform.InstanceState{
+		ID: "12",
+		Attributes: map[string]string{
+			"id":                         "12",
+			"nrn":                        "organization=1",
+			"dimensions.%":               "1",
+			"dimensions.environment":     "dev",
+			"dimensions_all.%":           "2",
+			"dimensions_all.environment": "dev",
+			"dimensions_all.country":     "ar",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		state           *terraform.InstanceState
+		defaults        map[string]string
+		wantChanged     map[string]string
+		wantRequiresNew bool
+	}{
+		{
+			name:        "create",
+			defaults:    map[string]string{"environment": "prod", "country": "ar"},
+			wantChanged: map[string]string{"environment": "dev", "country": "ar"},
+		},
+		{
+			name:     "defaults unchanged",
+			state:    state,
+			defaults: map[string]string{"environment": "prod", "country": "ar"},
+		},
+		{
+			name:            "default added",
+			state:           state,
+			defaults:        map[string]string{"country": "ar", "region": "east"},
+			wantChanged:     map[string]string{"region": "east"},
+			wantRequiresNew: true,
+		},
+	}
+
+	server := newDimensionsTestServer(t)
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(server)
+			client.DefaultDimensions = tt.defaults
+
+			diff, err := r.SimpleDiff(context.Background(), tt.state, config, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := map[string]string{}
+			requiresNew := false
+			if diff != nil {
+				for key, attr := range diff.Attributes {
+					if name, ok := strings.CutPrefix(key, "dimensions_all."); ok && name != "%" {
+						got[name] = attr.New
+						requiresNew = requiresNew || attr.RequiresNew
+					}
+				}
+			}
+			if tt.wantChanged == nil {
+				tt.wantChanged = map[string]string{}
+			}
+			if !reflect.DeepEqual(got, tt.wantChanged) {
+				t.Errorf("planned dimensions_all changes = %v, want %v", got, tt.wantChanged)
+			}
+			if tt.state != nil && requiresNew != tt.wantRequiresNew {
+				t.Errorf("requires new = %v, want %v", requiresNew, tt.wantRequiresNew)
+			}
+		})
+	}
+}
+
+func TestRuntimeConfigurationCreate_SendsDefaultDimensions(t *testing.T) {
+	var sent RuntimeConfiguration
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewDecoder(r.Body).Decode(&sent)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":         12,
+			"nrn":        "organization=1",
+			"dimensions": map[string]string{"environment": "dev", "country": "ar"},
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.DefaultDimensions = map[string]string{"environment": "prod", "country": "ar"}
+
+	d := schema.TestResourceDataRaw(t, resourceRuntimeConfiguration().Schema, map[string]interface{}{
+		"nrn":        "organization=1",
+		"dimensions": map[string]interface{}{"environment": "dev"},
+	})
+	if diags := RuntimeConfigurationCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if want := map[string]string{"environment": "dev", "country": "ar"}; !reflect.DeepEqual(sent.Dimensions, want) {
+		t.Errorf("sent dimensions %v, want %v", sent.Dimensions, want)
+	}
+	if got := d.Get("dimensions").(map[string]interface{}); !reflect.DeepEqual(got, map[string]interface{}{"environment": "dev"}) {
+		t.Errorf("dimensions = %v, want only the configured ones", got)
+	}
+}
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const DEPLOYMENT_STRATEGY_PATH = "/deployment_strategy"
@@ -23,24 +23,20 @@ type DeploymentStrategy struct {
 	UpdatedAt    string                 `json:"updated_at,omitempty"`
 }
 
-func (c *NullClient) CreateDeploymentStrategy(ds *DeploymentStrategy) (*DeploymentStrategy, error) {
+func (c *NullClient) CreateDeploymentStrategy(ctx context.Context, ds *DeploymentStrategy) (*DeploymentStrategy, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*ds); err != nil {
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", DEPLOYMENT_STRATEGY_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", DEPLOYMENT_STRATEGY_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating deployment strategy resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "deployment strategy", "create"); err != nil {
+		return nil, err
 	}
 
 	dsRes := &DeploymentStrategy{}
@@ -51,17 +47,17 @@ func (c *NullClient) CreateDeploymentStrategy(ds *DeploymentStrategy) (*Deployme
 	return dsRes, nil
 }
 
-func (c *NullClient) GetDeploymentStrategy(dsId string) (*DeploymentStrategy, error) {
+func (c *NullClient) GetDeploymentStrategy(ctx context.Context, dsId string) (*DeploymentStrategy, error) {
 	path := fmt.Sprintf("%s/%s", DEPLOYMENT_STRATEGY_PATH, dsId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting deployment strategy resource, got %d for %s", res.StatusCode, dsId)
+	if err := checkResponse(res, "deployment strategy", "get"); err != nil {
+		return nil, err
 	}
 
 	ds := &DeploymentStrategy{}
@@ -72,7 +68,7 @@ func (c *NullClient) GetDeploymentStrategy(dsId string) (*DeploymentStrategy, er
 	return ds, nil
 }
 
-func (c *NullClient) PatchDeploymentStrategy(dsId string, ds *DeploymentStrategy) error {
+func (c *NullClient) PatchDeploymentStrategy(ctx context.Context, dsId string, ds *DeploymentStrategy) error {
 	path := fmt.Sprintf("%s/%s", DEPLOYMENT_STRATEGY_PATH, dsId)
 
 	var buf bytes.Buffer
@@ -80,38 +76,28 @@ func (c *NullClient) PatchDeploymentStrategy(dsId string, ds *DeploymentStrategy
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating deployment strategy resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "deployment strategy", "update")
 }
 
-func (c *NullClient) DeleteDeploymentStrategy(dsId string) error {
+func (c *NullClient) DeleteDeploymentStrategy(ctx context.Context, dsId string) error {
 	path := fmt.Sprintf("%s/%s", DEPLOYMENT_STRATEGY_PATH, dsId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "deployment strategy", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting deployment strategy, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
@@ -0,0 +1,140 @@
+package nullplatform
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// diagFromErr is diag.FromErr for errors coming back from the API: the
+// summary names the failed operation, the detail carries the API message,
+// error code and request ID, and a validation failure yields one diagnostic
+// per field the API named, pointing at the attribute of the same name. An
+// action Terraform stopped waiting for is reported as interrupted. Any other
+// error is converted as diag.FromErr would.
+func diagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var orphanedErr *OrphanedActionError
+	if errors.As(err, &orphanedErr) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Action Interrupted",
+			Detail:   err.Error(),
+		}}
+	}
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	summary := "Failed to " + apiErr.Operation
+	if apiErr.Operation == "" {
+		summary = "API request failed"
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) && len(apiErr.Fields) > 0 {
+		diags := make(diag.Diagnostics, 0, len(apiErr.Fields))
+		for _, field := range apiErr.Fields {
+			detail := field.Message
+			if field.Field != "" {
+				detail = fmt.Sprintf("%s: %s", field.Field, field.Message)
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       summary,
+				Detail:        apiErrorDetail(detail, apiErr, ""),
+				AttributePath: fieldAttributePath(field.Field),
+			})
+		}
+		return diags
+	}
+
+	var hint string
+	var forbiddenErr *ForbiddenError
+	var throttledErr *ThrottledError
+	switch {
+	case errors.As(err, &forbiddenErr):
+		hint = "The API key is not allowed to perform this operation. Check the grants of the API key on the resource NRN."
+	case errors.As(err, &throttledErr):
+		hint = "The API is still rate limiting the provider after every retry. Lower `max_requests_per_second` or `max_concurrent_requests` in the provider block, or run Terraform with a lower -parallelism."
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   apiErrorDetail(wrappingMessage(err, apiErr), apiErr, hint),
+	}}
+}
+
+// wrappingMessage is the message of the error a client method returned,
+// which may add context around the API error, e.g. "Parameter ID 12 not
+// found: failed to get parameter: ...".
+func wrappingMessage(err error, apiErr *APIError) string {
+	msg := err.Error()
+	if msg == apiErr.Error() {
+		return apiErr.Message
+	}
+	return msg
+}
+
+func apiErrorDetail(message string, apiErr *APIError, hint string) string {
+	var sb strings.Builder
+	sb.WriteString(message)
+
+	sb.WriteString("\n\nHTTP status: ")
+	sb.WriteString(strconv.Itoa(apiErr.Status))
+	if apiErr.Code != "" {
+		sb.WriteString("\nError code: ")
+		sb.WriteString(apiErr.Code)
+	}
+	if apiErr.RequestID != "" {
+		sb.WriteString("\nRequest ID: ")
+		sb.WriteString(apiErr.RequestID)
+	}
+	if hint != "" {
+		sb.WriteString("\n\n")
+		sb.WriteString(hint)
+	}
+	return sb.String()
+}
+
+// fieldAttributePath points at the top-level attribute a field of the
+// request body belongs to: "dimensions.environment" points at dimensions. The
+// body mirrors the schema at the top level only, nested fields are named in
+// the detail instead. Terraform shows paths that match no attribute against
+// the resource block.
+func fieldAttributePath(field string) cty.Path {
+	if field == "" {
+		return nil
+	}
+	return cty.GetAttrPath(strings.SplitN(field, ".", 2)[0])
+}
+
+// removeIfNotFound handles the error of the lookup at the top of a Read. When
+// the entity no longer exists, because the API answered 404 or the client
+// reported a soft-deleted entity as a ResourceNotFoundError, it logs a
+// warning and clears the ID so that Terraform plans to create the resource
+// again. Any other error is returned as diagnostics, and so is a missing
+// entity right after Create, which Terraform would otherwise report as an
+// inconsistent result.
+func removeIfNotFound(d *schema.ResourceData, err error) diag.Diagnostics {
+	notFound, ok := IsResourceNotFoundError(err)
+	if !ok || d.IsNewResource() {
+		return diagFromErr(err)
+	}
+
+	log.Printf("[WARN] %s %s no longer exists, removing it from state: %v", notFound.ApiType, d.Id(), err)
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,53 @@
+package nullplatform
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestDiagFromErr(t *testing.T) {
+	if diags := diagFromErr(nil); diags != nil {
+		t.Errorf("diagFromErr(nil) = %v, want nil", diags)
+	}
+
+	diags := diagFromErr(errors.New("boom"))
+	if len(diags) != 1 || diags[0].Summary != "boom" {
+		t.Errorf("diagFromErr(plain error) = %v, want the diag.FromErr conversion", diags)
+	}
+
+	validation := checkResponse(newTestResponse(http.StatusBadRequest, http.Header{"X-Request-Id": {"req-1"}},
+		`{"message":"Invalid body","code":"VALIDATION","errors":[{"field":"dimensions.environment","message":"unknown dimension"},{"field":"name","message":"is required"}]}`),
+		"scope", "create")
+	diags = diagFromErr(fmt.Errorf("creating scope: %w", validation))
+	if len(diags) != 2 {
+		t.Fatalf("diagFromErr(validation) returned %d diagnostics, want one per field: %v", len(diags), diags)
+	}
+	if diags[0].Summary != "Failed to create scope" {
+		t.Errorf("Summary = %q", diags[0].Summary)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("dimensions")) {
+		t.Errorf("AttributePath = %#v, want dimensions", diags[0].AttributePath)
+	}
+	if !diags[1].AttributePath.Equals(cty.GetAttrPath("name")) {
+		t.Errorf("AttributePath = %#v, want name", diags[1].AttributePath)
+	}
+	for _, want := range []string{"dimensions.environment: unknown dimension", "HTTP status: 400", "Error code: VALIDATION", "Request ID: req-1"} {
+		if !strings.Contains(diags[0].Detail, want) {
+			t.Errorf("Detail %q does not contain %q", diags[0].Detail, want)
+		}
+	}
+
+	forbidden := checkResponse(newTestResponse(http.StatusForbidden, nil, `{"message":"Forbidden"}`), "service", "delete")
+	diags = diagFromErr(forbidden)
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Fatalf("diagFromErr(forbidden) = %v, want a single diagnostic without attribute", diags)
+	}
+	if diags[0].Summary != "Failed to delete service" || !strings.Contains(diags[0].Detail, "grants of the API key") {
+		t.Errorf("diagFromErr(forbidden) = %+v", diags[0])
+	}
+}
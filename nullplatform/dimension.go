@@ -2,10 +2,11 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -20,37 +21,29 @@ type Dimension struct {
 	Order  int              `json:"order,omitempty"`
 	Values []DimensionValue `json:"values,omitempty"`
 }
-type ErrorResponse struct {
-	Message string `json:"message"`
-	Code    string `json:"code"`
-}
 
-func (c *NullClient) CreateDimension(d *Dimension) (*Dimension, error) {
+func (c *NullClient) CreateDimension(ctx context.Context, d *Dimension) (*Dimension, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(d)
 	if err != nil {
 		return nil, fmt.Errorf("error encoding dimension: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", DIMENSION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", DIMENSION_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error making POST request: %v", err)
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "dimension", "create"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return nil, fmt.Errorf("API error creating dimension: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error creating dimension resource, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	createdDimension := &Dimension{}
 	err = json.Unmarshal(body, createdDimension)
 	if err != nil {
@@ -60,32 +53,28 @@ func (c *NullClient) CreateDimension(d *Dimension) (*Dimension, error) {
 	return createdDimension, nil
 }
 
-func (c *NullClient) GetDimension(ID, name, slug, status, nrn *string) (*Dimension, error) {
+func (c *NullClient) GetDimension(ctx context.Context, ID, name, slug, status, nrn *string) (*Dimension, error) {
 	params := map[string]string{}
 
 	if ID != nil && *ID != "" {
 		if id, err := strconv.Atoi(*ID); err == nil && id > 0 {
 			path := fmt.Sprintf("%s/%s", DIMENSION_PATH, *ID)
 
-			res, err := c.MakeRequest("GET", path, nil)
+			res, err := c.MakeRequest(ctx, "GET", path, nil)
 			if err != nil {
 				return nil, fmt.Errorf("error making GET request: %w", err)
 			}
 			defer res.Body.Close()
 
+			if err := checkResponse(res, "dimension", "get"); err != nil {
+				return nil, err
+			}
+
 			body, err := io.ReadAll(res.Body)
 			if err != nil {
 				return nil, fmt.Errorf("error reading response body: %w", err)
 			}
 
-			if res.StatusCode != http.StatusOK {
-				var errResp ErrorResponse
-				if err := json.Unmarshal(body, &errResp); err == nil {
-					return nil, fmt.Errorf("API error getting dimension: %s (Code: %s)", errResp.Message, errResp.Code)
-				}
-				return nil, fmt.Errorf("error getting dimension resource, got status code: %d, body: %s", res.StatusCode, string(body))
-			}
-
 			dimension := &Dimension{}
 			err = json.Unmarshal(body, dimension)
 			if err != nil {
@@ -115,25 +104,21 @@ func (c *NullClient) GetDimension(ID, name, slug, status, nrn *string) (*Dimensi
 	queryString := c.PrepareQueryString(params)
 	path := fmt.Sprintf("%s%s", DIMENSION_PATH, queryString)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error making GET request: %v", err)
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "dimension", "get"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return nil, fmt.Errorf("API error getting dimension: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error getting dimension resource, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	response := &map[string]any{}
 	err = json.Unmarshal(body, response)
 	if err != nil {
@@ -166,7 +151,13 @@ func (c *NullClient) GetDimension(ID, name, slug, status, nrn *string) (*Dimensi
 	return &dimension, nil
 }
 
-func (c *NullClient) UpdateDimension(dimensionID string, d *Dimension) error {
+// ListDimensions returns the dimensions visible at nrn, with their values.
+func (c *NullClient) ListDimensions(ctx context.Context, nrn string) ([]*Dimension, error) {
+	path := fmt.Sprintf("%s?nrn=%s", DIMENSION_PATH, url.QueryEscape(nrn))
+	return listAllCached[*Dimension](ctx, c, path, "dimensions")
+}
+
+func (c *NullClient) UpdateDimension(ctx context.Context, dimensionID string, d *Dimension) error {
 	path := fmt.Sprintf("%s/%s", DIMENSION_PATH, dimensionID)
 
 	var buf bytes.Buffer
@@ -175,48 +166,28 @@ func (c *NullClient) UpdateDimension(dimensionID string, d *Dimension) error {
 		return fmt.Errorf("error encoding dimension: %v", err)
 	}
 
-	res, err := c.MakeRequest("PUT", path, &buf)
+	res, err := c.MakeRequest(ctx, "PUT", path, &buf)
 	if err != nil {
 		return fmt.Errorf("error making PUT request: %v", err)
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return fmt.Errorf("API error updating dimension: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return fmt.Errorf("error updating dimension resource, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
-	return nil
+	return checkResponse(res, "dimension", "update")
 }
 
-func (c *NullClient) DeleteDimension(dimensionID string) error {
+func (c *NullClient) DeleteDimension(ctx context.Context, dimensionID string) error {
 	path := fmt.Sprintf("%s/%s", DIMENSION_PATH, dimensionID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %v", err)
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return fmt.Errorf("API error deleting dimension: %s (Code: %s)", errResp.Message, errResp.Code)
+	if err := checkResponse(res, "dimension", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting dimension resource, got status code: %d, body: %s", res.StatusCode, string(body))
 	}
 
 	return nil
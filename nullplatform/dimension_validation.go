@@ -0,0 +1,226 @@
+package nullplatform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dimensionsNRNFunc returns the NRN whose dimensions a resource's dimensions
+// must be picked from, or "" when it is not known at plan time.
+type dimensionsNRNFunc func(ctx context.Context, d *schema.ResourceDiff, nullOps NullOps) (string, error)
+
+// dimensionsCustomizeDiff is the CustomizeDiff of every resource with
+// dimensions: it plans dimensions_all and checks every key and value against
+// the dimensions visible at the resource's NRN, so a typo fails the plan
+// instead of the apply. Keys and values that are not close to any known one
+// pass, since the configuration may create them in the same apply.
+func dimensionsCustomizeDiff(resolveNRN dimensionsNRNFunc) schema.CustomizeDiffFunc {
+	return customdiff.Sequence(
+		dimensionsAllCustomizeDiff,
+		validateDimensions(resolveNRN),
+	)
+}
+
+// nrnAttribute resolves the NRN from an attribute of the resource.
+func nrnAttribute(key string) dimensionsNRNFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, nullOps NullOps) (string, error) {
+		if !d.NewValueKnown(key) {
+			return "", nil
+		}
+		return d.Get(key).(string), nil
+	}
+}
+
+// scopeApplicationNRN resolves a scope's NRN to its application's, which is
+// where the dimensions a new scope can pick from are visible.
+func scopeApplicationNRN(ctx context.Context, d *schema.ResourceDiff, nullOps NullOps) (string, error) {
+	if d.NewValueKnown("nrn") && d.Get("nrn").(string) != "" {
+		return d.Get("nrn").(string), nil
+	}
+	if !d.NewValueKnown("null_application_id") {
+		return "", nil
+	}
+
+	application, err := nullOps.GetApplication(ctx, strconv.Itoa(d.Get("null_application_id").(int)))
+	if err != nil {
+		return "", err
+	}
+	return application.Nrn, nil
+}
+
+func validateDimensions(resolveNRN dimensionsNRNFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		// Dimensions that are already applied were accepted by the API;
+		// only check what this plan is about to send.
+		if d.Id() != "" && !d.HasChange("dimensions_all") {
+			return nil
+		}
+		if !d.NewValueKnown("dimensions") || !validatesDimensions(m) {
+			return nil
+		}
+
+		dimensions := dimensionsWithDefaults(d, m)
+		if len(dimensions) == 0 {
+			return nil
+		}
+
+		nullOps := m.(NullOps)
+		nrn, err := resolveNRN(ctx, d, nullOps)
+		if err != nil || nrn == "" {
+			if err != nil {
+				log.Printf("[WARN] not validating dimensions: resolving the NRN failed: %v", err)
+			}
+			return nil
+		}
+
+		available, err := nullOps.ListDimensions(ctx, nrn)
+		if err != nil {
+			// Leave it to the API to reject bad dimensions at apply rather
+			// than failing the plan because the lookup did.
+			log.Printf("[WARN] not validating dimensions: listing the dimensions of %s failed: %v", nrn, err)
+			return nil
+		}
+
+		return checkDimensions(dimensions, available, nrn, defaultDimensions(m), d.Get("dimensions").(map[string]interface{}))
+	}
+}
+
+// validatesDimensions reports whether the provider's validate_dimensions is
+// on.
+func validatesDimensions(m interface{}) bool {
+	if client, ok := m.(*NullClient); ok {
+		return !client.SkipDimensionValidation
+	}
+	return true
+}
+
+// checkDimensions returns an error naming every key or value of dimensions
+// that is not among the available dimensions but close to one that is. Keys
+// and values unlike any available one are only logged: they may be created
+// by the same apply.
+func checkDimensions(dimensions map[string]string, available []*Dimension, nrn string, defaults map[string]string, configured map[string]interface{}) error {
+	byKey := map[string]*Dimension{}
+	var keys []string
+	for _, dimension := range available {
+		byKey[dimension.Slug] = dimension
+		byKey[dimension.Name] = dimension
+		keys = append(keys, dimension.Slug)
+	}
+	sort.Strings(keys)
+
+	// Report in a stable order.
+	names := make([]string, 0, len(dimensions))
+	for key := range dimensions {
+		names = append(names, key)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, key := range names {
+		value := dimensions[key]
+
+		attribute := fmt.Sprintf("dimensions[%q]", key)
+		if _, ok := configured[key]; !ok {
+			if _, ok := defaults[key]; ok {
+				attribute = fmt.Sprintf("the provider's default_dimensions[%q]", key)
+			}
+		}
+
+		dimension, ok := byKey[key]
+		if !ok {
+			suggestion := didYouMean(key, keys)
+			if suggestion == "" {
+				log.Printf("[WARN] %s: no dimension %q is defined at %s yet; assuming it is created in this apply", attribute, key, nrn)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("%s: no dimension %q is defined at %s.%s Available dimensions: %s",
+				attribute, key, nrn, suggestion, quotedList(keys)))
+			continue
+		}
+
+		var values []string
+		found := false
+		for _, v := range dimension.Values {
+			if v.Slug == value || v.Name == value {
+				found = true
+				break
+			}
+			values = append(values, v.Slug)
+		}
+		if found {
+			continue
+		}
+		sort.Strings(values)
+		suggestion := didYouMean(value, values)
+		if suggestion == "" {
+			log.Printf("[WARN] %s: %q is not a value of dimension %q at %s yet; assuming it is created in this apply", attribute, value, dimension.Slug, nrn)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: %q is not a value of dimension %q at %s.%s Available values: %s",
+			attribute, value, dimension.Slug, nrn, suggestion, quotedList(values)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// didYouMean suggests the candidate closest to input, if one is close enough
+// to be a likely typo.
+func didYouMean(input string, candidates []string) string {
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(input), strings.ToLower(candidate))
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	maxDistance := len(input) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if bestDistance < 0 || bestDistance > maxDistance {
+		return ""
+	}
+	return fmt.Sprintf(" Did you mean %q?", best)
+}
+
+func quotedList(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
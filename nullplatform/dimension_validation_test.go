@@ -0,0 +1,161 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newDimensionsTestServer answers the dimensions list with environment
+// (dev, prod), country (ar) and region (east).
+func newDimensionsTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != DIMENSION_PATH {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"results": []map[string]interface{}{
+				{"id": 1, "name": "Environment", "slug": "environment", "values": []map[string]interface{}{
+					{"id": 10, "name": "Development", "slug": "dev"},
+					{"id": 11, "name": "Production", "slug": "prod"},
+				}},
+				{"id": 2, "name": "Country", "slug": "country", "values": []map[string]interface{}{
+					{"id": 20, "name": "Argentina", "slug": "ar"},
+				}},
+				{"id": 3, "name": "Region", "slug": "region", "values": []map[string]interface{}{
+					{"id": 30, "name": "East", "slug": "east"},
+				}},
+			},
+		})
+	}))
+}
+
+func TestValidateDimensions(t *testing.T) {
+	server := newDimensionsTestServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		dimensions map[string]interface{}
+		defaults   map[string]string
+		wantErrs   []string
+	}{
+		{
+			name:       "valid slugs",
+			dimensions: map[string]interface{}{"environment": "dev", "country": "ar"},
+		},
+		{
+			name:       "valid names",
+			dimensions: map[string]interface{}{"Environment": "Production"},
+		},
+		{
+			name:       "misspelled key",
+			dimensions: map[string]interface{}{"enviroment": "dev"},
+			wantErrs:   []string{`dimensions["enviroment"]`, `Did you mean "environment"?`},
+		},
+		{
+			name:       "misspelled value",
+			dimensions: map[string]interface{}{"environment": "prd"},
+			wantErrs:   []string{`dimensions["environment"]: "prd" is not a value`, `Did you mean "prod"?`, `"dev", "prod"`},
+		},
+		{
+			name:       "key created in the same apply",
+			dimensions: map[string]interface{}{"team": "payments"},
+		},
+		{
+			name:       "value created in the same apply",
+			dimensions: map[string]interface{}{"country": "uruguay"},
+		},
+		{
+			name:       "bad default",
+			dimensions: map[string]interface{}{"environment": "dev"},
+			defaults:   map[string]string{"country": "br"},
+			wantErrs:   []string{`the provider's default_dimensions["country"]`},
+		},
+		{
+			name:       "every error reported",
+			dimensions: map[string]interface{}{"enviroment": "dev", "region": "west"},
+			wantErrs:   []string{`dimensions["enviroment"]`, `dimensions["region"]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(server)
+			client.DefaultDimensions = tt.defaults
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"nrn":        "organization=1:account=2",
+				"dimensions": tt.dimensions,
+			})
+			_, err := resourceRuntimeConfiguration().SimpleDiff(context.Background(), nil, config, client)
+
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDimensions_SkippedWhenTurnedOff(t *testing.T) {
+	server := newDimensionsTestServer(t)
+	defer server.Close()
+	client := newTestClient(server)
+	client.SkipDimensionValidation = true
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"nrn":        "organization=1",
+		"dimensions": map[string]interface{}{"enviroment": "dev"},
+	})
+	if _, err := resourceRuntimeConfiguration().SimpleDiff(context.Background(), nil, config, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateDimensions_SkippedWhenLookupFails(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"nrn":        "organization=1",
+		"dimensions": map[string]interface{}{"enviroment": "dev"},
+	})
+	if _, err := resourceRuntimeConfiguration().SimpleDiff(context.Background(), nil, config, newTestClient(server)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	candidates := []string{"country", "environment", "region"}
+	tests := map[string]string{
+		"enviroment":  ` Did you mean "environment"?`,
+		"Environmnet": ` Did you mean "environment"?`,
+		"regoin":      ` Did you mean "region"?`,
+		"team":        "",
+	}
+	for input, want := range tests {
+		if got := didYouMean(input, candidates); got != want {
+			t.Errorf("didYouMean(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
@@ -2,10 +2,10 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 )
 
 type DimensionValue struct {
@@ -17,7 +17,7 @@ type DimensionValue struct {
 	Status      string `json:"status,omitempty"`
 }
 
-func (c *NullClient) CreateDimensionValue(dv *DimensionValue) (*DimensionValue, error) {
+func (c *NullClient) CreateDimensionValue(ctx context.Context, dv *DimensionValue) (*DimensionValue, error) {
 	path := fmt.Sprintf("%s/%d/value", DIMENSION_PATH, dv.DimensionID)
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(dv)
@@ -25,25 +25,21 @@ func (c *NullClient) CreateDimensionValue(dv *DimensionValue) (*DimensionValue,
 		return nil, fmt.Errorf("error encoding dimension value: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error making POST request: %v", err)
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "dimension value", "create"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return nil, fmt.Errorf("API error creating dimension value: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error creating dimension value, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	createdValue := &DimensionValue{}
 	err = json.Unmarshal(body, createdValue)
 	if err != nil {
@@ -53,28 +49,24 @@ func (c *NullClient) CreateDimensionValue(dv *DimensionValue) (*DimensionValue,
 	return createdValue, nil
 }
 
-func (c *NullClient) GetDimensionValue(dimensionID, valueID int) (*DimensionValue, error) {
+func (c *NullClient) GetDimensionValue(ctx context.Context, dimensionID, valueID int) (*DimensionValue, error) {
 	path := fmt.Sprintf("%s/%d/value/%d", DIMENSION_PATH, dimensionID, valueID)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error making GET request: %v", err)
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "dimension value", "get"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return nil, fmt.Errorf("API error getting dimension value: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error getting dimension value, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	value := &DimensionValue{}
 	err = json.Unmarshal(body, value)
 	if err != nil {
@@ -84,26 +76,19 @@ func (c *NullClient) GetDimensionValue(dimensionID, valueID int) (*DimensionValu
 	return value, nil
 }
 
-func (c *NullClient) DeleteDimensionValue(dimensionID, valueID int) error {
+func (c *NullClient) DeleteDimensionValue(ctx context.Context, dimensionID, valueID int) error {
 	path := fmt.Sprintf("%s/%d/value/%d", DIMENSION_PATH, dimensionID, valueID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %v", err)
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil {
-			return fmt.Errorf("API error deleting dimension value: %s (Code: %s)", errResp.Message, errResp.Code)
+	if err := checkResponse(res, "dimension value", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting dimension value, got status code: %d, body: %s", res.StatusCode, string(body))
 	}
 
 	return nil
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const ENTITY_HOOK_ACTION_PATH = "/entity_hook/action"
@@ -23,7 +23,7 @@ type EntityHookAction struct {
 	Status          string            `json:"status,omitempty"`
 }
 
-func (c *NullClient) CreateEntityHookAction(action *EntityHookAction) (*EntityHookAction, error) {
+func (c *NullClient) CreateEntityHookAction(ctx context.Context, action *EntityHookAction) (*EntityHookAction, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*action)
 
@@ -31,18 +31,14 @@ func (c *NullClient) CreateEntityHookAction(action *EntityHookAction) (*EntityHo
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", ENTITY_HOOK_ACTION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", ENTITY_HOOK_ACTION_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating entity hook action resource, got status code: %d, %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "entity hook action", "create"); err != nil {
+		return nil, err
 	}
 
 	actionRes := &EntityHookAction{}
@@ -55,7 +51,7 @@ func (c *NullClient) CreateEntityHookAction(action *EntityHookAction) (*EntityHo
 	return actionRes, nil
 }
 
-func (c *NullClient) PatchEntityHookAction(entityHookActionId string, action *EntityHookAction) error {
+func (c *NullClient) PatchEntityHookAction(ctx context.Context, entityHookActionId string, action *EntityHookAction) error {
 	path := fmt.Sprintf("%s/%s", ENTITY_HOOK_ACTION_PATH, entityHookActionId)
 
 	var buf bytes.Buffer
@@ -65,32 +61,28 @@ func (c *NullClient) PatchEntityHookAction(entityHookActionId string, action *En
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
-		}
-		return fmt.Errorf("error patching entity hook action resource, got status code: %d, %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "entity hook action", "update")
 }
 
-func (c *NullClient) GetEntityHookAction(entityHookActionId string) (*EntityHookAction, error) {
+func (c *NullClient) GetEntityHookAction(ctx context.Context, entityHookActionId string) (*EntityHookAction, error) {
 	path := fmt.Sprintf("%s/%s", ENTITY_HOOK_ACTION_PATH, entityHookActionId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "entity hook action", "get"); err != nil {
+		return nil, err
+	}
+
 	action := &EntityHookAction{}
 	derr := json.NewDecoder(res.Body).Decode(action)
 
@@ -99,28 +91,20 @@ func (c *NullClient) GetEntityHookAction(entityHookActionId string) (*EntityHook
 	}
 
 	if action.Status == "deleted" {
-		return action, fmt.Errorf("error getting entity hook action resource, the status is %s", action.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting entity hook action resource, got %d for %s", res.StatusCode, entityHookActionId)
+		return action, &ResourceNotFoundError{ApiType: "entity hook action", Message: fmt.Sprintf("the entity hook action is %s", action.Status)}
 	}
 
 	return action, nil
 }
 
-func (c *NullClient) DeleteEntityHookAction(entityHookActionId string) error {
+func (c *NullClient) DeleteEntityHookAction(ctx context.Context, entityHookActionId string) error {
 	path := fmt.Sprintf("%s/%s", ENTITY_HOOK_ACTION_PATH, entityHookActionId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting entity hook action resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "entity hook action", "delete")
 }
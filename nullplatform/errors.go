@@ -1,32 +1,84 @@
 package nullplatform
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
-type ApiError struct {
-	ID      int    `json:"id"`
-	Message string `json:"message"`
-}
+// requestIDHeaders are the headers the API and the gateways in front of it
+// use to identify a request, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Cf-Id"}
+
+// maxErrorBodyMessage bounds how much of a body that is not a JSON error is
+// quoted back in the error message.
+const maxErrorBodyMessage = 512
 
-func (e *ApiError) Error() string {
-	return fmt.Sprintf("%d: %s", e.ID, e.Message)
+// APIError is a response the API answered with a status outside 2xx. Every
+// client method decodes failures through checkResponse, which wraps it in one
+// of the typed errors below when the status has a meaning of its own.
+type APIError struct {
+	Operation string
+	Status    int
+	Code      string
+	Message   string
+	RequestID string
+	// ID is the numeric id some endpoints put in the error body, e.g. the
+	// entity that already exists.
+	ID     int
+	Fields []FieldError
 }
 
-type HTTPStatusError struct {
-	Status  int
+// FieldError is a validation failure the API attributed to one field of the
+// request body.
+type FieldError struct {
+	Field   string
 	Message string
 }
 
-func (e *HTTPStatusError) Error() string {
-	return fmt.Sprintf("status code: %d, message: %s", e.Status, e.Message)
+func (e *APIError) Error() string {
+	details := []string{fmt.Sprintf("status=%d", e.Status)}
+	if e.Code != "" {
+		details = append(details, "code="+e.Code)
+	}
+	if e.RequestID != "" {
+		details = append(details, "request_id="+e.RequestID)
+	}
+	return fmt.Sprintf("failed to %s: %s (%s)", e.Operation, e.Message, strings.Join(details, ", "))
 }
 
-func (e *HTTPStatusError) StatusCode() int {
-	return e.Status
+// ConflictError is a 409: the entity already exists or was modified
+// concurrently.
+type ConflictError struct{ *APIError }
+
+func (e *ConflictError) Unwrap() error { return e.APIError }
+
+// ValidationError is a 400 or 422: the API rejected the request body. Fields
+// lists the offending fields when the API names them.
+type ValidationError struct{ *APIError }
+
+func (e *ValidationError) Unwrap() error { return e.APIError }
+
+// ForbiddenError is a 401 or 403 that survived the token refresh: the API key
+// lacks the permissions the operation needs.
+type ForbiddenError struct{ *APIError }
+
+func (e *ForbiddenError) Unwrap() error { return e.APIError }
+
+// ThrottledError is a 429 that is still rate limited once the retries of the
+// retry policy are exhausted.
+type ThrottledError struct {
+	*APIError
+	RetryAfter time.Duration
 }
 
+func (e *ThrottledError) Unwrap() error { return e.APIError }
+
 type ResourceExistsError struct {
 	ApiType string
 	ID      int
@@ -48,16 +100,30 @@ func IsResourceExistsError(err error) (*ResourceExistsError, bool) {
 	return resourceExistsError, ok
 }
 
+// ResourceNotFoundError reports an entity that does not exist, either because
+// a lookup matched nothing or because the API answered 404, in which case API
+// carries the decoded response.
 type ResourceNotFoundError struct {
 	ApiType string
 	ID      int
 	Message string
+	API     *APIError
 }
 
 func (e *ResourceNotFoundError) Error() string {
+	if e.API != nil {
+		return e.API.Error()
+	}
 	return fmt.Sprintf("%s not found (%d): %s", e.ApiType, e.ID, e.Message)
 }
 
+func (e *ResourceNotFoundError) Unwrap() error {
+	if e.API == nil {
+		return nil
+	}
+	return e.API
+}
+
 func IsResourceNotFoundError(err error) (*ResourceNotFoundError, bool) {
 	if err == nil {
 		return nil, false
@@ -68,3 +134,164 @@ func IsResourceNotFoundError(err error) (*ResourceNotFoundError, bool) {
 
 	return resourceNotFoundError, ok
 }
+
+// AsAPIError returns the API response behind err, whichever typed error wraps
+// it.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+// checkResponse returns nil for 2xx responses and decodes any other status
+// into a typed error. apiType names the entity ("scope", "service action") and
+// verb the operation ("create", "get"), which together make up the message:
+// "failed to create scope: ...". The body is consumed on failure.
+func checkResponse(res *http.Response, apiType, verb string) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := decodeAPIError(res)
+	apiErr.Operation = verb + " " + apiType
+
+	switch res.StatusCode {
+	case http.StatusNotFound:
+		return &ResourceNotFoundError{ApiType: apiType, ID: apiErr.ID, Message: apiErr.Message, API: apiErr}
+	case http.StatusConflict:
+		return &ConflictError{apiErr}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return &ValidationError{apiErr}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &ForbiddenError{apiErr}
+	case http.StatusTooManyRequests:
+		retryAfter, _ := parseRetryAfter(res.Header.Get("Retry-After"))
+		return &ThrottledError{apiErr, retryAfter}
+	}
+	return apiErr
+}
+
+// decodeJSON checks the response and decodes its body into out.
+func decodeJSON(res *http.Response, apiType, verb string, out any) error {
+	if err := checkResponse(res, apiType, verb); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to %s %s: decoding the API response: %w", verb, apiType, err)
+	}
+	return nil
+}
+
+// apiErrorBody covers the error shapes the API and its gateways answer with:
+// {"message", "code"} from the services, Fastify's {"error", "message",
+// "validation"} and {"errors": [...]} from the validation layer.
+type apiErrorBody struct {
+	Message    string          `json:"message"`
+	Error      string          `json:"error"`
+	Code       json.RawMessage `json:"code"`
+	ID         json.RawMessage `json:"id"`
+	RequestID  string          `json:"request_id"`
+	Validation json.RawMessage `json:"validation"`
+	Errors     json.RawMessage `json:"errors"`
+	Details    json.RawMessage `json:"details"`
+}
+
+type apiFieldProblem struct {
+	Field        string `json:"field"`
+	Path         string `json:"path"`
+	Property     string `json:"property"`
+	InstancePath string `json:"instancePath"`
+	DataPath     string `json:"dataPath"`
+	Message      string `json:"message"`
+	Params       struct {
+		MissingProperty string `json:"missingProperty"`
+	} `json:"params"`
+}
+
+func decodeAPIError(res *http.Response) *APIError {
+	apiErr := &APIError{Status: res.StatusCode}
+
+	for _, header := range requestIDHeaders {
+		if v := res.Header.Get(header); v != "" {
+			apiErr.RequestID = v
+			break
+		}
+	}
+
+	raw, _ := io.ReadAll(res.Body)
+
+	var body apiErrorBody
+	if err := json.Unmarshal(raw, &body); err != nil {
+		apiErr.Message = strings.TrimSpace(string(raw))
+		if len(apiErr.Message) > maxErrorBodyMessage {
+			apiErr.Message = apiErr.Message[:maxErrorBodyMessage] + "..."
+		}
+	} else {
+		apiErr.Message = body.Message
+		if apiErr.Message == "" {
+			apiErr.Message = body.Error
+		}
+		apiErr.Code = rawScalar(body.Code)
+		apiErr.ID, _ = strconv.Atoi(rawScalar(body.ID))
+		if apiErr.RequestID == "" {
+			apiErr.RequestID = body.RequestID
+		}
+		for _, list := range []json.RawMessage{body.Validation, body.Errors, body.Details} {
+			// Only lists of field problems are understood; anything else
+			// under these keys is left to the message.
+			var problems []apiFieldProblem
+			if json.Unmarshal(list, &problems) != nil {
+				continue
+			}
+			for _, p := range problems {
+				if fe, ok := p.fieldError(); ok {
+					apiErr.Fields = append(apiErr.Fields, fe)
+				}
+			}
+		}
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(res.StatusCode)
+	}
+
+	return apiErr
+}
+
+// fieldError normalizes the field a problem points at to a dotted path
+// relative to the request body: "/body/name", "body.name" and "name" all
+// become "name".
+func (p apiFieldProblem) fieldError() (FieldError, bool) {
+	field := p.Field
+	for _, alt := range []string{p.Path, p.Property, p.InstancePath, p.DataPath} {
+		if field == "" {
+			field = alt
+		}
+	}
+	field = strings.Trim(strings.ReplaceAll(field, "/", "."), ".")
+	if field == "body" {
+		field = ""
+	}
+	field = strings.TrimPrefix(field, "body.")
+	if p.Params.MissingProperty != "" {
+		field = strings.Trim(field+"."+p.Params.MissingProperty, ".")
+	}
+
+	if field == "" && p.Message == "" {
+		return FieldError{}, false
+	}
+	return FieldError{Field: field, Message: p.Message}, true
+}
+
+// rawScalar renders a JSON string or number as text, "" for anything else.
+func rawScalar(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package nullplatform
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestResponse(status int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		body     string
+		wantType error
+		want     APIError
+	}{
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			body:     `{"message":"Scope not found","code":"SCOPE_NOT_FOUND"}`,
+			wantType: &ResourceNotFoundError{},
+			want:     APIError{Operation: "get scope", Status: 404, Code: "SCOPE_NOT_FOUND", Message: "Scope not found"},
+		},
+		{
+			name:     "conflict with the existing id",
+			status:   http.StatusConflict,
+			body:     `{"id":42,"message":"The user already exists"}`,
+			wantType: &ConflictError{},
+			want:     APIError{Operation: "get scope", Status: 409, Message: "The user already exists", ID: 42},
+		},
+		{
+			name:     "fastify validation",
+			status:   http.StatusBadRequest,
+			header:   http.Header{"X-Request-Id": {"req-1"}},
+			body:     `{"statusCode":400,"error":"Bad Request","message":"body must have required property 'name'","validation":[{"instancePath":"","params":{"missingProperty":"name"},"message":"must have required property 'name'"},{"instancePath":"/dimensions/environment","message":"must be string"}]}`,
+			wantType: &ValidationError{},
+			want: APIError{
+				Operation: "get scope",
+				Status:    400,
+				Message:   "body must have required property 'name'",
+				RequestID: "req-1",
+				Fields: []FieldError{
+					{Field: "name", Message: "must have required property 'name'"},
+					{Field: "dimensions.environment", Message: "must be string"},
+				},
+			},
+		},
+		{
+			name:     "errors list with numeric code",
+			status:   http.StatusUnprocessableEntity,
+			body:     `{"message":"Invalid request","code":1001,"request_id":"req-2","errors":[{"field":"body.slug","message":"is taken"}]}`,
+			wantType: &ValidationError{},
+			want: APIError{
+				Operation: "get scope",
+				Status:    422,
+				Code:      "1001",
+				Message:   "Invalid request",
+				RequestID: "req-2",
+				Fields:    []FieldError{{Field: "slug", Message: "is taken"}},
+			},
+		},
+		{
+			name:     "forbidden",
+			status:   http.StatusForbidden,
+			body:     `{"message":"Forbidden"}`,
+			wantType: &ForbiddenError{},
+			want:     APIError{Operation: "get scope", Status: 403, Message: "Forbidden"},
+		},
+		{
+			name:     "throttled",
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": {"3"}},
+			body:     `{"message":"Too many requests"}`,
+			wantType: &ThrottledError{},
+			want:     APIError{Operation: "get scope", Status: 429, Message: "Too many requests"},
+		},
+		{
+			name:     "gateway HTML page",
+			status:   http.StatusBadGateway,
+			header:   http.Header{"X-Amzn-Requestid": {"amzn-1"}},
+			body:     "<html>502 Bad Gateway</html>\n",
+			wantType: &APIError{},
+			want:     APIError{Operation: "get scope", Status: 502, Message: "<html>502 Bad Gateway</html>", RequestID: "amzn-1"},
+		},
+		{
+			name:     "empty body",
+			status:   http.StatusGatewayTimeout,
+			wantType: &APIError{},
+			want:     APIError{Operation: "get scope", Status: 504, Message: "Gateway Timeout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkResponse(newTestResponse(tt.status, tt.header, tt.body), "scope", "get")
+			if err == nil {
+				t.Fatal("checkResponse() = nil, want an error")
+			}
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantType) {
+				t.Errorf("checkResponse() returned %T, want %T", err, tt.wantType)
+			}
+
+			apiErr, ok := AsAPIError(err)
+			if !ok {
+				t.Fatalf("AsAPIError(%v) = false", err)
+			}
+			if !reflect.DeepEqual(*apiErr, tt.want) {
+				t.Errorf("decoded %+v, want %+v", *apiErr, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckResponse_Success(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusCreated, http.StatusNoContent} {
+		if err := checkResponse(newTestResponse(status, nil, `{}`), "scope", "get"); err != nil {
+			t.Errorf("checkResponse(%d) = %v, want nil", status, err)
+		}
+	}
+}
+
+func TestCheckResponse_TypedErrors(t *testing.T) {
+	err := checkResponse(newTestResponse(http.StatusNotFound, nil, `{"message":"Service not found"}`), "service", "get")
+	notFound, ok := IsResourceNotFoundError(err)
+	if !ok {
+		t.Fatalf("IsResourceNotFoundError(%v) = false", err)
+	}
+	if notFound.ApiType != "service" {
+		t.Errorf("ApiType = %q, want service", notFound.ApiType)
+	}
+	if want := "failed to get service: Service not found (status=404)"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	err = checkResponse(newTestResponse(http.StatusTooManyRequests, http.Header{"Retry-After": {"3"}}, ``), "scope", "create")
+	var throttled *ThrottledError
+	if !errors.As(err, &throttled) {
+		t.Fatalf("errors.As(%v, *ThrottledError) = false", err)
+	}
+	if throttled.RetryAfter != 3*time.Second {
+		t.Errorf("RetryAfter = %s, want 3s", throttled.RetryAfter)
+	}
+
+	err = checkResponse(newTestResponse(http.StatusInternalServerError, http.Header{"X-Request-Id": {"req-9"}}, `{"message":"boom","code":"INTERNAL"}`), "link", "create")
+	if want := "failed to create link: boom (status=500, code=INTERNAL, request_id=req-9)"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,113 @@
+package nullplatform
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultRequestTimeout      = 2 * time.Minute
+	defaultTLSHandshakeTimeout = 10 * time.Second
+)
+
+// getBaseURL resolves the URL every API path is appended to. `base_url` wins
+// over the host attributes; otherwise the host is reached over HTTPS.
+func getBaseURL(d *schema.ResourceData, host string) (string, diag.Diagnostics) {
+	v, ok := d.GetOk(BASE_URL)
+	if !ok {
+		return "https://" + host, nil
+	}
+
+	u, err := url.Parse(v.(string))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid Base URL",
+			Detail:   fmt.Sprintf("'base_url' must be an absolute http or https URL such as https://api.nullplatform.com, got %q.", v),
+		}}
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid Base URL",
+			Detail:   fmt.Sprintf("'base_url' must not contain a query string or fragment, got %q.", v),
+		}}
+	}
+
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
+
+// newHTTPClient builds the client every API call goes through from the
+// transport attributes of the provider block.
+func newHTTPClient(ctx context.Context, d *schema.ResourceData) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	requestTimeout, err := time.ParseDuration(d.Get(REQUEST_TIMEOUT).(string))
+	if err != nil {
+		return nil, diag.Errorf("invalid %s: %v", REQUEST_TIMEOUT, err)
+	}
+	handshakeTimeout, err := time.ParseDuration(d.Get(TLS_HANDSHAKE_TIMEOUT).(string))
+	if err != nil {
+		return nil, diag.Errorf("invalid %s: %v", TLS_HANDSHAKE_TIMEOUT, err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSHandshakeTimeout = handshakeTimeout
+
+	if v, ok := d.GetOk(HTTPS_PROXY); ok {
+		proxyURL, err := url.Parse(v.(string))
+		if err != nil || proxyURL.Host == "" {
+			return nil, diag.Errorf("invalid %s %q: must be an absolute URL such as http://proxy.internal:3128", HTTPS_PROXY, v)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if files := d.Get(CA_BUNDLE_FILES).([]interface{}); len(files) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for _, f := range files {
+			path := f.(string)
+			pem, err := os.ReadFile(path)
+			if err != nil {
+				return nil, diag.Errorf("reading CA bundle %s: %v", path, err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, diag.Errorf("CA bundle %s does not contain any PEM encoded certificate", path)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if d.Get(INSECURE_SKIP_VERIFY).(bool) {
+		tlsConfig.InsecureSkipVerify = true
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "TLS Verification Disabled",
+			Detail:   "'insecure_skip_verify' is set: the API certificate is not verified and the API key and tokens can be intercepted. Only use it against local or test endpoints.",
+		})
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &LoggingTransport{
+			Transport:   transport,
+			BaseContext: ctx,
+		},
+	}, diags
+}
@@ -2,11 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"os"
 )
 
 const LINK_PATH = "/link"
@@ -26,41 +24,34 @@ type Link struct {
 	Attributes             map[string]interface{} `json:"attributes,omitempty"`
 }
 
-func (c *NullClient) CreateLink(link *Link) (*Link, error) {
+func (c *NullClient) CreateLink(ctx context.Context, link *Link) (created *Link, adoptedAfter error, err error) {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(*link)
+	err = json.NewEncoder(&buf).Encode(*link)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.MakeRequest("POST", LINK_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", LINK_PATH, &buf)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		nErr := &NullErrors{}
-		dErr := json.NewDecoder(res.Body).Decode(nErr)
-		if res.StatusCode == http.StatusBadRequest {
-			if dErr != nil {
-				return nil, fmt.Errorf("an error happened: %s", dErr)
-			}
+		if isAmbiguousRequestError(err) {
+			return c.reconcileLinkCreate(ctx, link, err)
 		}
-		return nil, fmt.Errorf("error creating link resource, got status code: %d", nErr.Id)
+		return nil, nil, err
 	}
+	defer res.Body.Close()
 
 	linkRes := &Link{}
-	derr := json.NewDecoder(res.Body).Decode(linkRes)
-
-	if derr != nil {
-		return nil, derr
+	if err := decodeJSON(res, "link", "create", linkRes); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileLinkCreate(ctx, link, err)
+		}
+		return nil, nil, err
 	}
 
-	return linkRes, nil
+	return linkRes, nil, nil
 }
 
-func (c *NullClient) PatchLink(linkId string, link *Link) error {
+func (c *NullClient) PatchLink(ctx context.Context, linkId string, link *Link) error {
 	path := fmt.Sprintf("%s/%s", LINK_PATH, linkId)
 
 	var buf bytes.Buffer
@@ -69,46 +60,40 @@ func (c *NullClient) PatchLink(linkId string, link *Link) error {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		io.Copy(os.Stdout, res.Body)
-		return fmt.Errorf("error patching link resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "link", "update")
 }
 
-func (c *NullClient) DeleteLink(linkId string) error {
+func (c *NullClient) DeleteLink(ctx context.Context, linkId string) error {
 	path := fmt.Sprintf("%s/%s", LINK_PATH, linkId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		io.Copy(os.Stdout, res.Body)
-		return fmt.Errorf("error deleting link resource, got %d for %s", res.StatusCode, linkId)
-	}
-
-	return nil
+	return checkResponse(res, "link", "delete")
 }
 
-func (c *NullClient) GetLink(linkId string) (*Link, error) {
+func (c *NullClient) GetLink(ctx context.Context, linkId string) (*Link, error) {
 	path := fmt.Sprintf("%s/%s", LINK_PATH, linkId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "link", "get"); err != nil {
+		return nil, err
+	}
+
 	link := &Link{}
 	derr := json.NewDecoder(res.Body).Decode(link)
 
@@ -116,10 +101,5 @@ func (c *NullClient) GetLink(linkId string) (*Link, error) {
 		return nil, derr
 	}
 
-	if res.StatusCode != http.StatusOK {
-		io.Copy(os.Stdout, res.Body)
-		return nil, fmt.Errorf("error getting link resource, got %d for %s", res.StatusCode, linkId)
-	}
-
 	return link, nil
 }
@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -34,22 +33,21 @@ type LinkSpecification struct {
 	ExternalResolution map[string]interface{} `json:"external_resolution,omitempty"`
 }
 
-func (c *NullClient) CreateLinkSpecification(s *LinkSpecification) (*LinkSpecification, error) {
+func (c *NullClient) CreateLinkSpecification(ctx context.Context, s *LinkSpecification) (*LinkSpecification, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*s)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode link specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", LINK_SPECIFICATION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", LINK_SPECIFICATION_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create link specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "link specification", "create"); err != nil {
+		return nil, err
 	}
 
 	sRes := &LinkSpecification{}
@@ -60,18 +58,17 @@ func (c *NullClient) CreateLinkSpecification(s *LinkSpecification) (*LinkSpecifi
 	return sRes, nil
 }
 
-func (c *NullClient) GetLinkSpecification(specId string) (*LinkSpecification, error) {
+func (c *NullClient) GetLinkSpecification(ctx context.Context, specId string) (*LinkSpecification, error) {
 	path := fmt.Sprintf("%s/%s", LINK_SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get link specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "link specification", "get"); err != nil {
+		return nil, err
 	}
 
 	spec := &LinkSpecification{}
@@ -82,7 +79,7 @@ func (c *NullClient) GetLinkSpecification(specId string) (*LinkSpecification, er
 	return spec, nil
 }
 
-func (c *NullClient) PatchLinkSpecification(specId string, s *LinkSpecification) error {
+func (c *NullClient) PatchLinkSpecification(ctx context.Context, specId string, s *LinkSpecification) error {
 	path := fmt.Sprintf("%s/%s", LINK_SPECIFICATION_PATH, specId)
 
 	var buf bytes.Buffer
@@ -91,32 +88,28 @@ func (c *NullClient) PatchLinkSpecification(specId string, s *LinkSpecification)
 		return fmt.Errorf("failed to encode link specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch link specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "link specification", "update")
 }
 
-func (c *NullClient) DeleteLinkSpecification(specId string) error {
+func (c *NullClient) DeleteLinkSpecification(ctx context.Context, specId string) error {
 	path := fmt.Sprintf("%s/%s", LINK_SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete link specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "link specification", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
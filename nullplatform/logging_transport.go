@@ -0,0 +1,189 @@
+package nullplatform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the terraform-plugin-log subsystem API calls are logged
+// under. Its level is read from TF_LOG_PROVIDER_NULLPLATFORM_HTTP and falls
+// back to the provider level (TF_LOG_PROVIDER, then TF_LOG) when unset.
+const (
+	httpLogSubsystem = "http"
+	httpLogLevelEnv  = "TF_LOG_PROVIDER_NULLPLATFORM"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveBodyKeys are masked wherever they appear in a JSON body, at any
+// depth: credentials sent to /token, the value of a created API key and the
+// api_key of agent notification channels.
+var sensitiveBodyKeys = map[string]bool{
+	"access_token":  true,
+	"api_key":       true,
+	"apikey":        true,
+	"client_secret": true,
+	"password":      true,
+	"refresh_token": true,
+	"token":         true,
+}
+
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// LoggingTransport logs every API call through tflog: a one line summary at
+// DEBUG and the headers and bodies, with secrets masked, at TRACE.
+type LoggingTransport struct {
+	Transport http.RoundTripper
+	// BaseContext carries the provider logger for requests built without a
+	// context of their own (http.NewRequest uses context.Background, which
+	// has no logger attached and would drop every line).
+	BaseContext context.Context
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if ctx == context.Background() && t.BaseContext != nil {
+		ctx = t.BaseContext
+	}
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv(httpLogLevelEnv, httpLogSubsystem))
+	ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "http_method", req.Method)
+	ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "http_url", req.URL.String())
+
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending API request")
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "API request details", map[string]interface{}{
+		"http_headers": redactHeaders(req.Header),
+		"http_body":    redactBody(req.URL.Path, reqBody),
+	})
+
+	startTime := time.Now()
+	res, err := t.Transport.RoundTrip(req)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "API request failed", map[string]interface{}{
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
+		return nil, err
+	}
+
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received API response", map[string]interface{}{
+		"http_status": res.StatusCode,
+		"duration_ms": duration.Milliseconds(),
+	})
+
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "API response details", map[string]interface{}{
+		"http_status":  res.StatusCode,
+		"http_headers": redactHeaders(res.Header),
+		"http_body":    redactBody(req.URL.Path, resBody),
+	})
+
+	return res, nil
+}
+
+// peekRequestBody returns a copy of the request body without consuming it.
+// Requests built from a bytes.Buffer carry GetBody, so the transport still
+// sends the original.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	return raw, nil
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		out[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := out[name]; ok {
+			out[name] = redactedValue
+		}
+	}
+	return out
+}
+
+// redactBody renders a body for the logs with every sensitive value masked.
+// Parameter values are masked on every /parameter endpoint: the value
+// endpoints do not say whether the parameter is secret. Bodies that are not
+// JSON cannot be inspected and are left out.
+func redactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON content omitted>", len(body))
+	}
+
+	redacted, err := json.Marshal(redactJSON(doc, strings.Contains(path, PARAMETER_PATH)))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes omitted: %v>", len(body), err)
+	}
+	return string(redacted)
+}
+
+func redactJSON(v interface{}, maskValues bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		secret, _ := v["secret"].(bool)
+		for key, child := range v {
+			switch {
+			case sensitiveBodyKeys[strings.ToLower(key)] && child != nil:
+				v[key] = redactedValue
+			case key == "value" && (maskValues || secret):
+				v[key] = redactedValue
+			case key == "values" && secret:
+				v[key] = redactJSON(child, true)
+			default:
+				v[key] = redactJSON(child, maskValues)
+			}
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactJSON(child, maskValues)
+		}
+		return v
+	default:
+		return v
+	}
+}
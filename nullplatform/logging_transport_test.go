@@ -0,0 +1,141 @@
+package nullplatform
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		want string
+	}{
+		{
+			name: "token request credentials",
+			path: TOKEN_PATH,
+			body: `{"apikey":"secret-key","refresh_token":"secret-refresh"}`,
+			want: `{"apikey":"REDACTED","refresh_token":"REDACTED"}`,
+		},
+		{
+			name: "created api key",
+			path: API_KEY_PATH,
+			body: `{"id":7,"name":"ci","api_key":"plain-text-key"}`,
+			want: `{"api_key":"REDACTED","id":7,"name":"ci"}`,
+		},
+		{
+			name: "notification channel agent api key",
+			path: "/notification/channel",
+			body: `{"type":"agent","configuration":{"agent":{"api_key":"agent-key","command":{"type":"exec"}}}}`,
+			want: `{"configuration":{"agent":{"api_key":"REDACTED","command":{"type":"exec"}}},"type":"agent"}`,
+		},
+		{
+			name: "secret parameter outside the parameter endpoints",
+			path: "/scope/1",
+			body: `{"parameters":[{"secret":true,"values":[{"id":"1","value":"hunter2"}]},{"secret":false,"values":[{"id":"2","value":"visible"}]}]}`,
+			want: `{"parameters":[{"secret":true,"values":[{"id":"1","value":"REDACTED"}]},{"secret":false,"values":[{"id":"2","value":"visible"}]}]}`,
+		},
+		{
+			name: "parameter value endpoint",
+			path: "/api/parameter/12/value",
+			body: `{"nrn":"organization=1","value":"hunter2"}`,
+			want: `{"nrn":"organization=1","value":"REDACTED"}`,
+		},
+		{
+			name: "null secrets are kept",
+			path: API_KEY_PATH,
+			body: `{"api_key":null}`,
+			want: `{"api_key":null}`,
+		},
+		{
+			name: "non JSON body",
+			path: "/scope",
+			body: `<html>bad gateway</html>`,
+			want: `<24 bytes of non-JSON content omitted>`,
+		},
+		{
+			name: "empty body",
+			path: "/scope",
+			body: ``,
+			want: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody(tt.path, []byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport_RoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"apikey":"secret-key"}` {
+			t.Errorf("request body was altered: %s", body)
+		}
+		w.Write([]byte(`{"access_token":"secret-token"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	transport := &LoggingTransport{
+		Transport:   http.DefaultTransport,
+		BaseContext: tflogtest.RootLogger(context.Background(), &output),
+	}
+	client := &http.Client{Transport: transport}
+
+	req, err := http.NewRequest("POST", server.URL+TOKEN_PATH, bytes.NewBufferString(`{"apikey":"secret-key"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	if string(body) != `{"access_token":"secret-token"}` {
+		t.Errorf("response body was not handed to the caller: %s", body)
+	}
+
+	if strings.Contains(output.String(), "secret-") {
+		t.Errorf("secrets leaked into the logs:\n%s", output.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	levels := map[string]string{}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+httpLogSubsystem {
+			t.Errorf("entry logged outside the http subsystem: %v", entry)
+		}
+		levels[entry["@message"].(string)] = entry["@level"].(string)
+	}
+
+	want := map[string]string{
+		"Sending API request":   "debug",
+		"API request details":   "trace",
+		"Received API response": "debug",
+		"API response details":  "trace",
+	}
+	if !reflect.DeepEqual(levels, want) {
+		t.Errorf("logged %v, want %v", levels, want)
+	}
+}
@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 type Metadata struct {
@@ -16,7 +15,7 @@ func getMetadataPath(entity, entityId, metadataType string) string {
 	return fmt.Sprintf("/metadata/%s/%s/%s", entity, entityId, metadataType)
 }
 
-func (c *NullClient) CreateMetadata(entity, entityId, metadataType string, m *Metadata) error {
+func (c *NullClient) CreateMetadata(ctx context.Context, entity, entityId, metadataType string, m *Metadata) error {
 	path := getMetadataPath(entity, entityId, metadataType)
 
 	var buf bytes.Buffer
@@ -25,32 +24,26 @@ func (c *NullClient) CreateMetadata(entity, entityId, metadataType string, m *Me
 		return fmt.Errorf("failed to encode metadata: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to create metadata: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "metadata", "create")
 }
 
-func (c *NullClient) GetMetadata(entity, entityId, metadataType string) (*Metadata, error) {
+func (c *NullClient) GetMetadata(ctx context.Context, entity, entityId, metadataType string) (*Metadata, error) {
 	path := getMetadataPath(entity, entityId, metadataType)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get metadata: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata", "get"); err != nil {
+		return nil, err
 	}
 
 	var value interface{}
@@ -61,7 +54,7 @@ func (c *NullClient) GetMetadata(entity, entityId, metadataType string) (*Metada
 	return &Metadata{Value: value}, nil
 }
 
-func (c *NullClient) UpdateMetadata(entity, entityId, metadataType string, m *Metadata) error {
+func (c *NullClient) UpdateMetadata(ctx context.Context, entity, entityId, metadataType string, m *Metadata) error {
 	path := getMetadataPath(entity, entityId, metadataType)
 
 	var buf bytes.Buffer
@@ -70,32 +63,28 @@ func (c *NullClient) UpdateMetadata(entity, entityId, metadataType string, m *Me
 		return fmt.Errorf("failed to encode metadata: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to update metadata: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "metadata", "update")
 }
 
-func (c *NullClient) DeleteMetadata(entity, entityId, metadataType string) error {
+func (c *NullClient) DeleteMetadata(ctx context.Context, entity, entityId, metadataType string) error {
 	path := getMetadataPath(entity, entityId, metadataType)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete metadata: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
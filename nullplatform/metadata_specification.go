@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -22,22 +21,21 @@ type MetadataSpecification struct {
 	Schema      map[string]interface{} `json:"schema,omitempty"`
 }
 
-func (c *NullClient) CreateMetadataSpecification(m *MetadataSpecification) (*MetadataSpecification, error) {
+func (c *NullClient) CreateMetadataSpecification(ctx context.Context, m *MetadataSpecification) (*MetadataSpecification, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*m)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode metadata specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", METADATA_SPECIFICATION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", METADATA_SPECIFICATION_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create metadata specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata specification", "create"); err != nil {
+		return nil, err
 	}
 
 	mRes := &MetadataSpecification{}
@@ -48,7 +46,7 @@ func (c *NullClient) CreateMetadataSpecification(m *MetadataSpecification) (*Met
 	return mRes, nil
 }
 
-func (c *NullClient) UpdateMetadataSpecification(id string, m *MetadataSpecification) (*MetadataSpecification, error) {
+func (c *NullClient) UpdateMetadataSpecification(ctx context.Context, id string, m *MetadataSpecification) (*MetadataSpecification, error) {
 	path := fmt.Sprintf("%s/%s", METADATA_SPECIFICATION_PATH, id)
 
 	var buf bytes.Buffer
@@ -57,15 +55,14 @@ func (c *NullClient) UpdateMetadataSpecification(id string, m *MetadataSpecifica
 		return nil, fmt.Errorf("failed to encode metadata specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to update metadata specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata specification", "update"); err != nil {
+		return nil, err
 	}
 
 	mRes := &MetadataSpecification{}
@@ -76,18 +73,17 @@ func (c *NullClient) UpdateMetadataSpecification(id string, m *MetadataSpecifica
 	return mRes, nil
 }
 
-func (c *NullClient) GetMetadataSpecification(id string) (*MetadataSpecification, error) {
+func (c *NullClient) GetMetadataSpecification(ctx context.Context, id string) (*MetadataSpecification, error) {
 	path := fmt.Sprintf("%s/%s", METADATA_SPECIFICATION_PATH, id)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get metadata specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata specification", "get"); err != nil {
+		return nil, err
 	}
 
 	m := &MetadataSpecification{}
@@ -98,20 +94,21 @@ func (c *NullClient) GetMetadataSpecification(id string) (*MetadataSpecification
 	return m, nil
 }
 
-func (c *NullClient) DeleteMetadataSpecification(id string) error {
+func (c *NullClient) DeleteMetadataSpecification(ctx context.Context, id string) error {
 	path := fmt.Sprintf("%s/%s", METADATA_SPECIFICATION_PATH, id)
 
 	emptyBody := bytes.NewBuffer([]byte("{}"))
 
-	res, err := c.MakeRequest("DELETE", path, emptyBody)
+	res, err := c.MakeRequest(ctx, "DELETE", path, emptyBody)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete metadata specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "metadata specification", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
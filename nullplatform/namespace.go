@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const NAMESPACE_PATH = "/namespace"
@@ -18,39 +18,35 @@ type Namespace struct {
 	Nrn       string `json:"nrn,omitempty"`
 }
 
-func (c *NullClient) CreateNamespace(namespace *Namespace) (*Namespace, error) {
+func (c *NullClient) CreateNamespace(ctx context.Context, namespace *Namespace) (created *Namespace, adoptedAfter error, err error) {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(*namespace)
+	err = json.NewEncoder(&buf).Encode(*namespace)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.MakeRequest("POST", NAMESPACE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", NAMESPACE_PATH, &buf)
 	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
+		if isAmbiguousRequestError(err) {
+			return c.reconcileNamespaceCreate(ctx, namespace, err)
 		}
-		return nil, fmt.Errorf("error creating namespace resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+		return nil, nil, err
 	}
+	defer res.Body.Close()
 
 	namespaceRes := &Namespace{}
-	derr := json.NewDecoder(res.Body).Decode(namespaceRes)
-
-	if derr != nil {
-		return nil, derr
+	if err := decodeJSON(res, "namespace", "create", namespaceRes); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileNamespaceCreate(ctx, namespace, err)
+		}
+		return nil, nil, err
 	}
 
-	return namespaceRes, nil
+	return namespaceRes, nil, nil
 }
 
-func (c *NullClient) PatchNamespace(namespaceId string, namespace *Namespace) error {
+func (c *NullClient) PatchNamespace(ctx context.Context, namespaceId string, namespace *Namespace) error {
 	path := fmt.Sprintf("%s/%s", NAMESPACE_PATH, namespaceId)
 
 	var buf bytes.Buffer
@@ -60,32 +56,28 @@ func (c *NullClient) PatchNamespace(namespaceId string, namespace *Namespace) er
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating namespace resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "namespace", "update")
 }
 
-func (c *NullClient) GetNamespace(namespaceId string) (*Namespace, error) {
+func (c *NullClient) GetNamespace(ctx context.Context, namespaceId string) (*Namespace, error) {
 	path := fmt.Sprintf("%s/%s", NAMESPACE_PATH, namespaceId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "namespace", "get"); err != nil {
+		return nil, err
+	}
+
 	namespace := &Namespace{}
 	derr := json.NewDecoder(res.Body).Decode(namespace)
 
@@ -94,31 +86,25 @@ func (c *NullClient) GetNamespace(namespaceId string) (*Namespace, error) {
 	}
 
 	if namespace.Status == "deleted" {
-		return namespace, fmt.Errorf("error getting namespace resource, the status is %s", namespace.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting namespace resource, got %d for %s", res.StatusCode, namespaceId)
+		return namespace, &ResourceNotFoundError{ApiType: "namespace", Message: fmt.Sprintf("the namespace is %s", namespace.Status)}
 	}
 
 	return namespace, nil
 }
 
-func (c *NullClient) DeleteNamespace(namespaceId string) error {
+func (c *NullClient) DeleteNamespace(ctx context.Context, namespaceId string) error {
 	path := fmt.Sprintf("%s/%s", NAMESPACE_PATH, namespaceId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "namespace", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting namespace, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const NOTIFICATION_CHANNEL_PATH = "/notification/channel"
@@ -20,27 +20,21 @@ type NotificationChannel struct {
 	Filters       map[string]interface{} `json:"filters"`
 }
 
-func (c *NullClient) CreateNotificationChannel(notification *NotificationChannel) (*NotificationChannel, error) {
+func (c *NullClient) CreateNotificationChannel(ctx context.Context, notification *NotificationChannel) (*NotificationChannel, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*notification)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("Request body: %s\n", buf.String())
-
-	res, err := c.MakeRequest("POST", NOTIFICATION_CHANNEL_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", NOTIFICATION_CHANNEL_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating notification channel, got status code: %d, %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "notification channel", "create"); err != nil {
+		return nil, err
 	}
 
 	resNotification := &NotificationChannel{}
@@ -51,17 +45,17 @@ func (c *NullClient) CreateNotificationChannel(notification *NotificationChannel
 	return resNotification, nil
 }
 
-func (c *NullClient) GetNotificationChannel(notificationId string) (*NotificationChannel, error) {
+func (c *NullClient) GetNotificationChannel(ctx context.Context, notificationId string) (*NotificationChannel, error) {
 	path := fmt.Sprintf("%s/%s", NOTIFICATION_CHANNEL_PATH, notificationId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting notification channel, got %d for %s", res.StatusCode, notificationId)
+	if err := checkResponse(res, "notification channel", "get"); err != nil {
+		return nil, err
 	}
 
 	notification := &NotificationChannel{}
@@ -72,41 +66,31 @@ func (c *NullClient) GetNotificationChannel(notificationId string) (*Notificatio
 	return notification, nil
 }
 
-func (c *NullClient) UpdateNotificationChannel(notificationId string, notification *NotificationChannel) error {
+func (c *NullClient) UpdateNotificationChannel(ctx context.Context, notificationId string, notification *NotificationChannel) error {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*notification)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Request body: %s\n", buf.String())
-
 	path := fmt.Sprintf("%s/%s", NOTIFICATION_CHANNEL_PATH, notificationId)
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("error updating notification channel, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "notification channel", "update")
 }
 
-func (c *NullClient) DeleteNotificationChannel(notificationId string) error {
+func (c *NullClient) DeleteNotificationChannel(ctx context.Context, notificationId string) error {
 	path := fmt.Sprintf("%s/%s", NOTIFICATION_CHANNEL_PATH, notificationId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting notification channel, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "notification channel", "delete")
 }
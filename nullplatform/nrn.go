@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
-	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,20 +12,6 @@ import (
 
 const NRN_PATH = "/nrn"
 
-// Vales with `omitempty` shoudn't be patched as empty values, the others can.
-// The struct is used to PATCH the NRN
-type PatchNRN struct {
-	AWSS3AssestBucket               string `json:"aws.s3_assets_bucket,omitempty"`
-	AWSScopeWorkflowRole            string `json:"aws.scope_workflow_role,omitempty"`
-	AWSLogGroupName                 string `json:"aws.log_group_name,omitempty"`
-	AWSLambdaFunctionName           string `json:"aws.lambdaFunctionName,omitempty"`
-	AWSLambdaCurrentFunctionVersion string `json:"aws.lambdaCurrentFunctionVersion,omitempty"`
-	AWSLambdaFunctionRole           string `json:"aws.lambdaFunctionRole,omitempty"`
-	AWSLambdaFunctionMainAlias      string `json:"aws.lambdaFunctionMainAlias,omitempty"`
-	AWSLogReaderLog                 string `json:"aws.log_reader_role,omitempty"`
-	AWSLambdaFunctionWarmAlias      string `json:"aws.lambdaFunctionWarmAlias,omitempty"`
-}
-
 var NRNSchema = map[string]*schema.Schema{
 	"account": {
 		Type:        schema.TypeString,
@@ -53,38 +38,42 @@ var NRNSchema = map[string]*schema.Schema{
 		Description: "The slug of the scope NRN component.",
 	},
 	"nrn": {
-		Type:          schema.TypeString,
-		Optional:      true,
-		Computed:      true,
-		ForceNew:      true,
-		Description:   "A system-wide unique ID representing the resource.",
-		ConflictsWith: []string{"account", "namespace", "application", "scope"},
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		Description:      "A system-wide unique ID representing the resource.",
+		ConflictsWith:    []string{"account", "namespace", "application", "scope"},
+		ValidateDiagFunc: validateNRN,
 	},
 }
 
-// Similar structure to PatchNRN but without the `.aws`.
-// The struct is used to READ the NRN
-type NrnAwsNamespace struct {
-	AWSS3AssestBucket               string `json:"s3_assets_bucket,omitempty"`
-	AWSScopeWorkflowRole            string `json:"scope_workflow_role,omitempty"`
-	AWSLogGroupName                 string `json:"log_group_name,omitempty"`
-	AWSLambdaFunctionName           string `json:"lambdaFunctionName,omitempty"`
-	AWSLambdaCurrentFunctionVersion string `json:"lambdaCurrentFunctionVersion,omitempty"`
-	AWSLambdaFunctionRole           string `json:"lambdaFunctionRole,omitempty"`
-	AWSLambdaFunctionMainAlias      string `json:"lambdaFunctionMainAlias,omitempty"`
-	AWSLogReaderLog                 string `json:"log_reader_role,omitempty"`
-	AWSLambdaFunctionWarmAlias      string `json:"lambdaFunctionWarmAlias,omitempty"`
+// NRNNamespaces is the data stored on an NRN, as read by GetNRN: the value of
+// each key, by namespace and key. The key aws.log_group_name is
+// Namespaces["aws"]["log_group_name"].
+type NRNNamespaces struct {
+	Nrn        string                            `json:"nrn,omitempty"`
+	Namespaces map[string]map[string]interface{} `json:"namespaces,omitempty"`
 }
 
-type Namespaces struct {
-	AWS    *NrnAwsNamespace  `json:"aws,omitempty"`
-	Github map[string]string `json:"github,omitempty"`
-	Global map[string]string `json:"global,omitempty"`
-}
-
-type NRN struct {
-	Nrn        string      `json:"nrn,omitempty"`
-	Namespaces *Namespaces `json:"namespaces,omitempty"`
+// Value returns the value of namespace.key, "" when it is not set. Values
+// that are not strings are returned JSON encoded.
+func (n *NRNNamespaces) Value(namespace, key string) string {
+	if n == nil {
+		return ""
+	}
+	v, ok := n.Namespaces[namespace][key]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
 }
 
 func AddNRNSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
@@ -94,53 +83,41 @@ func AddNRNSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
 	return s
 }
 
-func (c *NullClient) PatchNRN(nrnId string, nrn *PatchNRN) error {
+// PatchNRN writes values to the NRN nrnId. Keys are namespace.key, such as
+// aws.log_group_name; a nil value removes the key. Keys not in values are
+// left as they are.
+func (c *NullClient) PatchNRN(ctx context.Context, nrnId string, values map[string]interface{}) error {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode((*nrn))
+	err := json.NewEncoder(&buf).Encode(values)
 	if err != nil {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", fmt.Sprintf("%s/%s", NRN_PATH, nrnId), &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", fmt.Sprintf("%s/%s", NRN_PATH, nrnId), &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error patching nrn resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "NRN", "update")
 }
 
-func (c *NullClient) GetNRN(nrnId string) (*NRN, error) {
-	// Slice to store JSON attributes
-	var namespaces []string
-
-	// Using `aws.*` returns an error, so instead
-	// use reflection to obtain the JSON attributes for the struct
-	patchNRN := PatchNRN{}
-	t := reflect.TypeOf(patchNRN)
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		jsonTag := field.Tag.Get("json")
-		namespaces = append(namespaces, jsonTag)
-	}
+// GetNRN reads keys, each namespace.key, from the NRN nrnId. The API has no
+// way to list a namespace, so only the keys asked for are returned.
+func (c *NullClient) GetNRN(ctx context.Context, nrnId string, keys []string) (*NRNNamespaces, error) {
+	path := fmt.Sprintf("%s/%s?ids=%s", NRN_PATH, nrnId, strings.Join(keys, ","))
 
-	path := fmt.Sprintf("%s/%s?ids=%s", NRN_PATH, nrnId, strings.Join(namespaces, ","))
-
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting nrn resource, got %d", res.StatusCode)
+	if err := checkResponse(res, "NRN", "get"); err != nil {
+		return nil, err
 	}
 
-	s := &NRN{}
+	s := &NRNNamespaces{}
 	derr := json.NewDecoder(res.Body).Decode(s)
 
 	if derr != nil {
@@ -151,19 +128,19 @@ func (c *NullClient) GetNRN(nrnId string) (*NRN, error) {
 
 }
 
-func ConstructNRNFromComponents(d *schema.ResourceData, nullOps NullOps) (string, error) {
+func ConstructNRNFromComponents(ctx context.Context, d *schema.ResourceData, nullOps NullOps) (string, error) {
 	client := nullOps.(*NullClient)
 
-	organizationID, err := client.GetOrganizationIDFromToken()
+	organizationID, err := client.GetOrganizationID(ctx)
 	if err != nil {
-		return "", fmt.Errorf("error getting organization ID from token: %v", err)
+		return "", fmt.Errorf("error getting organization ID: %v", err)
 	}
 
 	nrnParts := []string{fmt.Sprintf("organization=%s", organizationID)}
 
 	components := []struct {
 		key       string
-		getFunc   func(string, string) (map[string]interface{}, error)
+		getFunc   func(context.Context, string, string) (map[string]interface{}, error)
 		parentKey string
 	}{
 		{"account", nullOps.GetAccountBySlug, "organization"},
@@ -175,23 +152,14 @@ func ConstructNRNFromComponents(d *schema.ResourceData, nullOps NullOps) (string
 	parentID := organizationID
 	for _, component := range components {
 		if v, ok := d.GetOk(component.key); ok {
-			result, err := component.getFunc(parentID, v.(string))
+			result, err := component.getFunc(ctx, parentID, v.(string))
 			if err != nil {
 				return "", fmt.Errorf("error resolving %s: %v", component.key, err)
 			}
 
-			var id string
-			switch idVal := result["id"].(type) {
-			case string:
-				id = idVal
-			case float64:
-				id = fmt.Sprintf("%.0f", idVal)
-			default:
-				return "", fmt.Errorf("%s has invalid ID type: %T. Full result: %v", component.key, result["id"], result)
-			}
-
-			if id == "" {
-				return "", fmt.Errorf("%s has empty ID. Value: %s, Full result: %v", component.key, v.(string), result)
+			id, err := slugLookupID(component.key, result)
+			if err != nil {
+				return "", err
 			}
 
 			nrnParts = append(nrnParts, fmt.Sprintf("%s=%s", component.key, id))
@@ -204,35 +172,35 @@ func ConstructNRNFromComponents(d *schema.ResourceData, nullOps NullOps) (string
 	return strings.Join(nrnParts, ":"), nil
 }
 
-func (c *NullClient) GetAccountBySlug(organizationID, slug string) (map[string]interface{}, error) {
+func (c *NullClient) GetAccountBySlug(ctx context.Context, organizationID, slug string) (map[string]interface{}, error) {
 	path := fmt.Sprintf("/account?organization_id=%s&slug=%s", organizationID, slug)
-	return c.getEntityBySlug(path)
+	return c.getEntityBySlug(ctx, "account", path)
 }
 
-func (c *NullClient) GetNamespaceBySlug(accountID, slug string) (map[string]interface{}, error) {
+func (c *NullClient) GetNamespaceBySlug(ctx context.Context, accountID, slug string) (map[string]interface{}, error) {
 	path := fmt.Sprintf("/namespace?account_id=%s&slug=%s", accountID, slug)
-	return c.getEntityBySlug(path)
+	return c.getEntityBySlug(ctx, "namespace", path)
 }
 
-func (c *NullClient) GetApplicationBySlug(namespaceID, slug string) (map[string]interface{}, error) {
+func (c *NullClient) GetApplicationBySlug(ctx context.Context, namespaceID, slug string) (map[string]interface{}, error) {
 	path := fmt.Sprintf("/application?namespace_id=%s&slug=%s", namespaceID, slug)
-	return c.getEntityBySlug(path)
+	return c.getEntityBySlug(ctx, "application", path)
 }
 
-func (c *NullClient) GetScopeBySlug(applicationID, slug string) (map[string]interface{}, error) {
+func (c *NullClient) GetScopeBySlug(ctx context.Context, applicationID, slug string) (map[string]interface{}, error) {
 	path := fmt.Sprintf("/scope?application_id=%s&slug=%s", applicationID, slug)
-	return c.getEntityBySlug(path)
+	return c.getEntityBySlug(ctx, "scope", path)
 }
 
-func (c *NullClient) getEntityBySlug(path string) (map[string]interface{}, error) {
-	resp, err := c.MakeRequest(http.MethodGet, path, nil)
+func (c *NullClient) getEntityBySlug(ctx context.Context, apiType, path string) (map[string]interface{}, error) {
+	resp, err := c.getCached(ctx, path)
 	if err != nil {
 		return nil, fmt.Errorf("API request failed: %v", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
+	if err := checkResponse(resp, apiType, "look up"); err != nil {
+		return nil, err
 	}
 
 	var result struct {
@@ -244,7 +212,7 @@ func (c *NullClient) getEntityBySlug(path string) (map[string]interface{}, error
 	}
 
 	if len(result.Results) == 0 {
-		return nil, fmt.Errorf("entity not found")
+		return nil, &ResourceNotFoundError{ApiType: apiType, Message: fmt.Sprintf("no match for %s", path)}
 	}
 
 	return result.Results[0], nil
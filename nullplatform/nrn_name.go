@@ -0,0 +1,202 @@
+package nullplatform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// nrnLevels are the components an NRN may have, outermost first. An NRN
+// always starts with the organization and names the others in this order.
+var nrnLevels = []string{"organization", "account", "namespace", "application", "scope"}
+
+// NRNWildcard stands for any ID in the last component of an NRN pattern, as
+// in organization=1:account=*.
+const NRNWildcard = "*"
+
+// NRNComponent is one key=id pair of an NRN.
+type NRNComponent struct {
+	Level string
+	ID    string
+}
+
+// NRN is a parsed nullplatform resource name, the path from an organization
+// down to an entity:
+//
+//	organization=1:account=2:namespace=3:application=4:scope=5
+//
+// The last component of an NRN used as a visibility pattern may be the
+// wildcard, matching every entity at that level.
+type NRN []NRNComponent
+
+// ParseNRN parses s, which may end in a wildcard.
+func ParseNRN(s string) (NRN, error) {
+	if s == "" {
+		return nil, fmt.Errorf("NRN is empty")
+	}
+
+	parts := strings.Split(s, ":")
+	nrn := make(NRN, 0, len(parts))
+	next := 0
+	for i, part := range parts {
+		level, id, ok := strings.Cut(part, "=")
+		if !ok || level == "" || id == "" {
+			return nil, fmt.Errorf("invalid NRN %q: component %q is not of the form <level>=<id>", s, part)
+		}
+		if i == 0 && level != "organization" {
+			return nil, fmt.Errorf("invalid NRN %q: must start with organization=<id>", s)
+		}
+
+		index := levelIndex(level)
+		if index < 0 {
+			return nil, fmt.Errorf("invalid NRN %q: unknown level %q, expected one of %s", s, level, strings.Join(nrnLevels, ", "))
+		}
+		if index < next {
+			return nil, fmt.Errorf("invalid NRN %q: %s must come before %s", s, level, nrn[len(nrn)-1].Level)
+		}
+		next = index + 1
+
+		if id == NRNWildcard {
+			if i != len(parts)-1 {
+				return nil, fmt.Errorf("invalid NRN %q: only the last component can be %s", s, NRNWildcard)
+			}
+		} else if strings.Trim(id, "0123456789") != "" {
+			return nil, fmt.Errorf("invalid NRN %q: %s ID %q is not a number", s, level, id)
+		}
+
+		nrn = append(nrn, NRNComponent{Level: level, ID: id})
+	}
+
+	return nrn, nil
+}
+
+func levelIndex(level string) int {
+	for i, l := range nrnLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// String formats n back into its level=id:level=id form.
+func (n NRN) String() string {
+	parts := make([]string, len(n))
+	for i, c := range n {
+		parts[i] = c.Level + "=" + c.ID
+	}
+	return strings.Join(parts, ":")
+}
+
+// Level returns the level of the entity n names, such as "namespace".
+func (n NRN) Level() string {
+	if len(n) == 0 {
+		return ""
+	}
+	return n[len(n)-1].Level
+}
+
+// ID returns the ID of level in n, "" when n does not reach that level.
+func (n NRN) ID(level string) string {
+	for _, c := range n {
+		if c.Level == level {
+			return c.ID
+		}
+	}
+	return ""
+}
+
+// IsWildcard reports whether n ends in the wildcard.
+func (n NRN) IsWildcard() bool {
+	return len(n) > 0 && n[len(n)-1].ID == NRNWildcard
+}
+
+// Parent returns the NRN of the entity n is in, and false when n is an
+// organization.
+func (n NRN) Parent() (NRN, bool) {
+	if len(n) <= 1 {
+		return nil, false
+	}
+	return n[: len(n)-1 : len(n)-1], true
+}
+
+// Parents returns the NRNs containing n, nearest first, ending with the
+// organization.
+func (n NRN) Parents() []NRN {
+	var parents []NRN
+	for parent, ok := n.Parent(); ok; parent, ok = parent.Parent() {
+		parents = append(parents, parent)
+	}
+	return parents
+}
+
+// Matches reports whether other names the same entity as n, or one of the
+// entities n's wildcard stands for.
+func (n NRN) Matches(other NRN) bool {
+	return len(n) == len(other) && n.Contains(other)
+}
+
+// Contains reports whether other is n, an entity below it, or, when n ends in
+// the wildcard, any of the entities it stands for or below them. This is how
+// visible_to and linkable_to grant access.
+func (n NRN) Contains(other NRN) bool {
+	if len(n) == 0 || len(other) < len(n) {
+		return false
+	}
+	for i, c := range n {
+		if c.Level != other[i].Level {
+			return false
+		}
+		if c.ID != NRNWildcard && c.ID != other[i].ID {
+			return false
+		}
+	}
+	return true
+}
+
+// validateNRN is the ValidateDiagFunc of attributes holding the NRN of an
+// entity.
+func validateNRN(v interface{}, path cty.Path) diag.Diagnostics {
+	nrn, diags := parseNRNAttribute(v, path)
+	if diags == nil && nrn.IsWildcard() {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid NRN",
+			Detail:        fmt.Sprintf("%q ends in a wildcard; this attribute needs the NRN of a single entity.", nrn),
+			AttributePath: path,
+		}}
+	}
+	return diags
+}
+
+// validateNRNPattern is the ValidateDiagFunc of visibility attributes, whose
+// NRNs may end in a wildcard.
+func validateNRNPattern(v interface{}, path cty.Path) diag.Diagnostics {
+	_, diags := parseNRNAttribute(v, path)
+	return diags
+}
+
+func parseNRNAttribute(v interface{}, path cty.Path) (NRN, diag.Diagnostics) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid NRN",
+			Detail:        fmt.Sprintf("Expected a string, got %T.", v),
+			AttributePath: path,
+		}}
+	}
+
+	nrn, err := ParseNRN(s)
+	if err != nil {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid NRN",
+			Detail:        err.Error() + ". An NRN looks like organization=1:account=2:namespace=3.",
+			AttributePath: path,
+		}}
+	}
+	return nrn, nil
+}
@@ -0,0 +1,212 @@
+package nullplatform
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestParseNRN(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    NRN
+		wantErr string
+	}{
+		{
+			input: "organization=1",
+			want:  NRN{{"organization", "1"}},
+		},
+		{
+			input: "organization=1:account=2:namespace=3:application=4:scope=5",
+			want:  NRN{{"organization", "1"}, {"account", "2"}, {"namespace", "3"}, {"application", "4"}, {"scope", "5"}},
+		},
+		{
+			input: "organization=1:namespace=3",
+			want:  NRN{{"organization", "1"}, {"namespace", "3"}},
+		},
+		{
+			input: "organization=1:account=*",
+			want:  NRN{{"organization", "1"}, {"account", "*"}},
+		},
+		{
+			input: "organization=*",
+			want:  NRN{{"organization", "*"}},
+		},
+		{input: "", wantErr: "NRN is empty"},
+		{input: "account=1", wantErr: "must start with organization=<id>"},
+		{input: "organization", wantErr: `component "organization" is not of the form <level>=<id>`},
+		{input: "organization=1:account=", wantErr: `component "account=" is not of the form`},
+		{input: "organization=1:", wantErr: `component "" is not of the form`},
+		{input: "organization=1:team=2", wantErr: `unknown level "team"`},
+		{input: "organization=1:namespace=3:account=2", wantErr: "account must come before namespace"},
+		{input: "organization=1:account=2:account=3", wantErr: "account must come before account"},
+		{input: "organization=1:account=abc", wantErr: `account ID "abc" is not a number`},
+		{input: "organization=1:account=*:namespace=3", wantErr: "only the last component can be *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseNRN(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseNRN(%q) error = %v, want it to contain %q", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseNRN(%q) unexpected error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseNRN(%q) = %#v, want %#v", tt.input, got, tt.want)
+			}
+			if got.String() != tt.input {
+				t.Errorf("String() = %q, want %q", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func mustParseNRN(t *testing.T, s string) NRN {
+	t.Helper()
+	nrn, err := ParseNRN(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nrn
+}
+
+func TestNRN_Accessors(t *testing.T) {
+	nrn := mustParseNRN(t, "organization=1:account=2:namespace=3")
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{"level", nrn.Level(), "namespace"},
+		{"organization id", nrn.ID("organization"), "1"},
+		{"namespace id", nrn.ID("namespace"), "3"},
+		{"missing level id", nrn.ID("scope"), ""},
+		{"is wildcard", nrn.IsWildcard(), false},
+		{"pattern is wildcard", mustParseNRN(t, "organization=1:account=*").IsWildcard(), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNRN_Parents(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"organization=1", nil},
+		{"organization=1:account=2", []string{"organization=1"}},
+		{"organization=1:account=2:namespace=3:application=4", []string{
+			"organization=1:account=2:namespace=3",
+			"organization=1:account=2",
+			"organization=1",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			nrn := mustParseNRN(t, tt.input)
+
+			var got []string
+			for _, parent := range nrn.Parents() {
+				got = append(got, parent.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parents() = %v, want %v", got, tt.want)
+			}
+
+			parent, ok := nrn.Parent()
+			if ok != (len(tt.want) > 0) {
+				t.Fatalf("Parent() ok = %v", ok)
+			}
+			if ok && parent.String() != tt.want[0] {
+				t.Errorf("Parent() = %s, want %s", parent, tt.want[0])
+			}
+		})
+	}
+}
+
+func TestNRN_ParentDoesNotAlias(t *testing.T) {
+	nrn := mustParseNRN(t, "organization=1:account=2")
+	parent, _ := nrn.Parent()
+	_ = append(parent, NRNComponent{"account", "9"})
+
+	if nrn.String() != "organization=1:account=2" {
+		t.Errorf("appending to the parent changed the NRN to %s", nrn)
+	}
+}
+
+func TestNRN_ContainsAndMatches(t *testing.T) {
+	tests := []struct {
+		pattern      string
+		other        string
+		wantContains bool
+		wantMatches  bool
+	}{
+		{"organization=1", "organization=1", true, true},
+		{"organization=1", "organization=1:account=2", true, false},
+		{"organization=1", "organization=2:account=2", false, false},
+		{"organization=1:account=2", "organization=1", false, false},
+		{"organization=1:account=2", "organization=1:account=3:namespace=4", false, false},
+		{"organization=1:account=2", "organization=1:namespace=2", false, false},
+		{"organization=1:account=*", "organization=1:account=7", true, true},
+		{"organization=1:account=*", "organization=1:account=7:namespace=8", true, false},
+		{"organization=1:account=*", "organization=1", false, false},
+		{"organization=1:account=*", "organization=2:account=7", false, false},
+		{"organization=*", "organization=5:account=6", true, false},
+		{"organization=*", "organization=5", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.other, func(t *testing.T) {
+			pattern, other := mustParseNRN(t, tt.pattern), mustParseNRN(t, tt.other)
+			if got := pattern.Contains(other); got != tt.wantContains {
+				t.Errorf("Contains() = %v, want %v", got, tt.wantContains)
+			}
+			if got := pattern.Matches(other); got != tt.wantMatches {
+				t.Errorf("Matches() = %v, want %v", got, tt.wantMatches)
+			}
+		})
+	}
+}
+
+func TestValidateNRN(t *testing.T) {
+	path := cty.GetAttrPath("nrn")
+	tests := []struct {
+		value       interface{}
+		wantEntity  bool
+		wantPattern bool
+	}{
+		{"organization=1:account=2", true, true},
+		{"organization=1:account=*", false, true},
+		{"organization=1:acount=2", false, false},
+		{"", false, false},
+		{42, false, false},
+	}
+
+	for _, tt := range tests {
+		diags := validateNRN(tt.value, path)
+		if got := !diags.HasError(); got != tt.wantEntity {
+			t.Errorf("validateNRN(%v) valid = %v, want %v: %v", tt.value, got, tt.wantEntity, diags)
+		}
+		if diags.HasError() && !diags[0].AttributePath.Equals(path) {
+			t.Errorf("validateNRN(%v) path = %v, want %v", tt.value, diags[0].AttributePath, path)
+		}
+
+		if got := !validateNRNPattern(tt.value, path).HasError(); got != tt.wantPattern {
+			t.Errorf("validateNRNPattern(%v) valid = %v, want %v", tt.value, got, tt.wantPattern)
+		}
+	}
+}
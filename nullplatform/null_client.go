@@ -2,295 +2,256 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
-	"net/http/httputil"
-	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 const TOKEN_PATH = "/token"
 
-const (
-	defaultGetMaxRetries = 3
-	defaultGetRetryDelay = 100 * time.Millisecond
-)
-
-func isRetryableStatusCode(statusCode int) bool {
-	switch statusCode {
-	case http.StatusRequestTimeout,
-		http.StatusConflict,
-		http.StatusTooManyRequests,
-		http.StatusBadGateway,
-		http.StatusServiceUnavailable,
-		http.StatusGatewayTimeout:
-		return true
-	}
-	return false
-}
+// tokenRefreshWindow is how long before its exp claim the access token is
+// proactively refreshed, so a request is never sent with a token that expires
+// while it is in flight.
+const tokenRefreshWindow = 2 * time.Minute
 
 type TokenRequest struct {
-	Apikey string `json:"apikey"`
+	Apikey       string `json:"apikey,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
 }
 
 type Token struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
-}
-
-type LoggingTransport struct {
-	Transport http.RoundTripper
-	Logger    *log.Logger
+	// Expiry is set when the token was issued with an explicit expiry, such
+	// as by an exec credential helper. Otherwise the exp claim is used.
+	Expiry time.Time `json:"-"`
 }
 
 type NullClient struct {
-	Client          *http.Client
-	ApiURL          string
-	ApiKey          string
-	Token           Token
-	tokenMutex      sync.Mutex
-	cachedOrgID     string
-	cachedOrgIDLock sync.RWMutex
-}
-
-type NullErrors struct {
-	Message string `json:"message"`
-	Id      int    `json:"id"`
+	Client      *http.Client
+	ApiURL      string
+	BaseURL     string
+	ApiKey      string
+	Token       Token
+	TokenSource TokenSource
+	RetryPolicy *RetryPolicy
+	Limiter     *RequestLimiter
+	PageSize    int
+	Cache       *ReadCache
+	// OrganizationID is the organization configured on the provider, if
+	// any. Use GetOrganizationID, which falls back to the token.
+	OrganizationID string
+	// DefaultDimensions are merged under the dimensions of every resource
+	// that sends them.
+	DefaultDimensions map[string]string
+	// SkipDimensionValidation turns off the plan-time check of dimensions
+	// against the ones defined at the resource's NRN.
+	SkipDimensionValidation bool
+	tokenMutex              sync.Mutex
+	cachedOrgID             string
+	orgIDMutex              sync.Mutex
 }
 
 type NullOps interface {
-	MakeRequest(method, path string, body *bytes.Buffer) (*http.Response, error)
-
-	CreateScope(*Scope) (*Scope, error)
-	PatchScope(string, *Scope) error
-	GetScope(string) (*Scope, error)
-	DeleteScope(string) error
-
-	PatchNRN(string, *PatchNRN) error
-	GetNRN(string) (*NRN, error)
-
-	CreateApplication(application *Application) (*Application, error)
-	GetApplication(appId string) (*Application, error)
-	PatchApplication(appId string, application *Application) error
-	DeleteApplication(appId string) error
-
-	CreateCapability(capability *CapabilityEntity) (*CapabilityEntity, error)
-	GetCapability(capabilityId string) (*CapabilityEntity, error)
-	PatchCapability(capabilityId string, capability *CapabilityEntity) error
-	DeleteCapability(capabilityId string) error
-
-	CreateDeploymentStrategy(ds *DeploymentStrategy) (*DeploymentStrategy, error)
-	GetDeploymentStrategy(dsId string) (*DeploymentStrategy, error)
-	PatchDeploymentStrategy(dsId string, ds *DeploymentStrategy) error
-	DeleteDeploymentStrategy(dsId string) error
-
-	CreateScopeDomain(sd *ScopeDomain) (*ScopeDomain, error)
-	GetScopeDomain(sdId string) (*ScopeDomain, error)
-	PatchScopeDomain(sdId string, sd *ScopeDomain) error
-	DeleteScopeDomain(sdId string) error
-
-	CreateService(*Service) (*Service, error)
-	GetService(string) (*Service, error)
-	PatchService(string, *Service) error
-	DeleteService(string, bool) error
-
-	CreateServiceAction(string, *ActionInstance) (*ActionInstance, error)
-	GetServiceAction(string, string) (*ActionInstance, error)
-	PatchServiceAction(string, string, *ActionInstance) (*ActionInstance, error)
-	DeleteServiceAction(string, string) error
-
-	CreateLink(*Link) (*Link, error)
-	PatchLink(string, *Link) error
-	DeleteLink(string) error
-	GetLink(string) (*Link, error)
-
-	CreateParameter(param *Parameter, importIfCreated bool) (*Parameter, error)
-	PatchParameter(parameterId string, param *Parameter) error
-	GetParameter(parameterId string, nrn *string) (*Parameter, error)
-	DeleteParameter(parameterId string) error
-	GetParameterList(nrn string, hideValues ...bool) (*ParameterList, error)
-
-	CreateParameterValue(paramId int, paramValue *ParameterValue) (*ParameterValue, error)
-	GetParameterValue(parameterId string, parameterValueId string, nrn *string) (*ParameterValue, error)
-	DeleteParameterValue(parameterId string, parameterValueId string) error
-
-	CreateApprovalAction(action *ApprovalAction) (*ApprovalAction, error)
-	PatchApprovalAction(approvalActionId string, action *ApprovalAction) error
-	GetApprovalAction(approvalActionId string) (*ApprovalAction, error)
-	DeleteApprovalAction(approvalActionId string) error
-
-	CreateApprovalPolicy(policy *ApprovalPolicy) (*ApprovalPolicy, error)
-	PatchApprovalPolicy(ApprovalPolicyId string, policy *ApprovalPolicy) error
-	GetApprovalPolicy(ApprovalPolicyId string) (*ApprovalPolicy, error)
-	DeleteApprovalPolicy(ApprovalPolicyId string) error
-
-	AssociatePolicyWithAction(approvalActionId, approvalPolicyID string) error
-	DisassociatePolicyFromAction(approvalActionId, approvalPolicyID string) error
-
-	CreateEntityHookAction(action *EntityHookAction) (*EntityHookAction, error)
-	PatchEntityHookAction(entityHookActionId string, action *EntityHookAction) error
-	GetEntityHookAction(entityHookActionId string) (*EntityHookAction, error)
-	DeleteEntityHookAction(entityHookActionId string) error
-
-	CreateNotificationChannel(notification *NotificationChannel) (*NotificationChannel, error)
-	GetNotificationChannel(notificationId string) (*NotificationChannel, error)
-	UpdateNotificationChannel(notificationId string, notification *NotificationChannel) error
-	DeleteNotificationChannel(notificationId string) error
-
-	CreateRuntimeConfiguration(rc *RuntimeConfiguration) (*RuntimeConfiguration, error)
-	PatchRuntimeConfiguration(runtimeConfigId string, rc *RuntimeConfiguration) error
-	GetRuntimeConfiguration(runtimeConfigId string) (*RuntimeConfiguration, error)
-	DeleteRuntimeConfiguration(runtimeConfigId string) error
-
-	CreateProviderConfig(p *ProviderConfig) (*ProviderConfig, error)
-	GetProviderConfig(providerConfigId string) (*ProviderConfig, error)
-	PatchProviderConfig(providerConfigId string, p *ProviderConfig) error
-	DeleteProviderConfig(providerConfigId string) error
-	GetSpecificationIdFromSlug(slug string, nrn string) (string, error)
-	GetSpecificationSlugFromId(id string) (string, error)
-
-	GetOrganizationIDFromToken() (string, error)
-	GetAccountBySlug(organizationID, slug string) (map[string]interface{}, error)
-	GetNamespaceBySlug(accountID, slug string) (map[string]interface{}, error)
-	GetApplicationBySlug(namespaceID, slug string) (map[string]interface{}, error)
-	GetScopeBySlug(applicationID, slug string) (map[string]interface{}, error)
-
-	CreateDimension(*Dimension) (*Dimension, error)
-	GetDimension(*string, *string, *string, *string, *string) (*Dimension, error)
-	UpdateDimension(string, *Dimension) error
-	DeleteDimension(string) error
-
-	CreateDimensionValue(dv *DimensionValue) (*DimensionValue, error)
-	GetDimensionValue(dimensionID, valueID int) (*DimensionValue, error)
-	DeleteDimensionValue(dimensionID, valueID int) error
-
-	CreateAccount(account *Account) (*Account, error)
-	GetAccount(accountId string) (*Account, error)
-	PatchAccount(accountId string, account *Account) error
-	DeleteAccount(accountId string) error
-
-	CreateNamespace(namespace *Namespace) (*Namespace, error)
-	GetNamespace(namespaceId string) (*Namespace, error)
-	PatchNamespace(namespaceId string, account *Namespace) error
-	DeleteNamespace(namespaceId string) error
-
-	CreateMetadataSpecification(spec *MetadataSpecification) (*MetadataSpecification, error)
-	GetMetadataSpecification(specId string) (*MetadataSpecification, error)
-	UpdateMetadataSpecification(specId string, spec *MetadataSpecification) (*MetadataSpecification, error)
-	DeleteMetadataSpecification(specId string) error
-
-	CreateServiceSpecification(s *ServiceSpecification) (*ServiceSpecification, error)
-	GetServiceSpecification(specId string) (*ServiceSpecification, error)
-	PatchServiceSpecification(specId string, s *ServiceSpecification) error
-	DeleteServiceSpecification(specId string) error
-
-	CreateLinkSpecification(s *LinkSpecification) (*LinkSpecification, error)
-	GetLinkSpecification(specId string) (*LinkSpecification, error)
-	PatchLinkSpecification(specId string, s *LinkSpecification) error
-	DeleteLinkSpecification(specId string) error
-
-	CreateActionSpecification(s *ActionSpecification) (*ActionSpecification, error)
-	GetActionSpecification(specId string, parentType string, parentId string) (*ActionSpecification, error)
-	ListActionSpecifications(serviceSpecId string) ([]*ActionSpecification, error)
-	ListLinkActionSpecifications(linkSpecId string) ([]*ActionSpecification, error)
-	PatchActionSpecification(specId string, s *ActionSpecification, parentType string, parentId string) error
-	DeleteActionSpecification(specId string, parentType string, parentId string) error
-
-	GetApiKey(apiKeyId int64) (*ApiKey, error)
-	CreateApiKey(body *CreateApiKeyRequestBody) (*CreateApiKeyResponseBody, error)
-	PatchApiKey(apiKeyId int64, body *PatchApiKeyRequestBody) error
-	DeleteApiKey(apiKeyId int64) error
-
-	CreateUser(u *User) (*User, error)
-	GetUser(userID string) (*User, error)
-	UpdateUser(userID string, u *User) error
-	DeleteUser(userID string) error
-	LookupUser(user *User) (*User, error)
-
-	CreateAuthzGrant(grant *AuthzGrant) (*AuthzGrant, error)
-	GetAuthzGrant(grantID string) (*AuthzGrant, error)
-	DeleteAuthzGrant(grantID string) error
-
-	CreateTechnologyTemplate(t *TechnologyTemplate) (*TechnologyTemplate, error)
-	GetTechnologyTemplate(templateId string) (*TechnologyTemplate, error)
-	PatchTechnologyTemplate(templateId string, t *TechnologyTemplate) error
-	DeleteTechnologyTemplate(templateId string) error
-
-	CreateMetadata(entity, entityId, metadataType string, m *Metadata) error
-	GetMetadata(entity, entityId, metadataType string) (*Metadata, error)
-	UpdateMetadata(entity, entityId, metadataType string, m *Metadata) error
-	DeleteMetadata(entity, entityId, metadataType string) error
-
-	CreateScopeType(s *ScopeType) (*ScopeType, error)
-	GetScopeType(scopeTypeId string) (*ScopeType, error)
-	PatchScopeType(scopeTypeId string, s *ScopeType) error
-	DeleteScopeType(scopeTypeId string) error
-
-	CreateProviderSpecification(s *ProviderSpecification) (*ProviderSpecification, error)
-	GetProviderSpecification(specId string) (*ProviderSpecification, error)
-	PatchProviderSpecification(specId string, s *ProviderSpecification) error
-	DeleteProviderSpecification(specId string) error
-
-	RegisterPlatformArtifact(r *PlatformArtifactRegistration) (*PlatformArtifactRevision, error)
-	GetPlatformArtifact(artifactID string) (*PlatformArtifact, error)
-	GetPlatformArtifactRevision(revisionID string) (*PlatformArtifactRevision, error)
-	ListPlatformArtifacts(nrn, artifactType string) ([]*PlatformArtifact, error)
-	ListPlatformArtifactRevisions(artifactID string) ([]*PlatformArtifactRevision, error)
-
-	UpsertPackage(p *PackageUpsert) (*Package, error)
-	GetPackage(packageID string) (*Package, error)
-	PatchPackage(packageID string, p *PackagePatch) error
-	DeletePackage(packageID string) error
-	FindPackage(nrn, slug string) (*Package, error)
-	ListPackageRevisions(packageID string) ([]*PackageRevision, error)
-	SetPackageTag(packageID, name string, body *PackageTagSet) error
-	DeletePackageTag(packageID, name string) error
-	GetLatestSnapshotID(kind, id string) (string, error)
-}
-
-func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-
-	// Log request
-	reqDump, err := httputil.DumpRequestOut(req, true)
-	if err != nil {
-		return nil, err
-	}
-	authRegex := regexp.MustCompile(`(?i)(Authorization:)(\s*)(Bearer|Basic|Digest|\S+)?(\s*)(.*)(\r?\n)`)
-	replacement := []byte("$1$2$3$4REDACTED$6")
-
-	// Replace the auth header line with an empty string
-	t.Logger.Printf("REQUEST:\n%s\n", string(authRegex.ReplaceAll(reqDump, replacement)))
-
-	// Set up timing
-	startTime := time.Now()
-
-	// Perform the request
-	resp, err := t.Transport.RoundTrip(req)
-	if err != nil {
-		t.Logger.Printf("REQUEST ERROR: %v\n", err)
-		return nil, err
-	}
-
-	// Calculate duration
-	duration := time.Since(startTime)
-
-	// Log response
-	respDump, err := httputil.DumpResponse(resp, true)
-	if err != nil {
-		t.Logger.Printf("ERROR DUMPING RESPONSE: %v\n", err)
-	} else {
-		t.Logger.Printf("RESPONSE (%s):\n%s\n", duration, string(respDump))
-	}
-
-	return resp, err
+	MakeRequest(ctx context.Context, method, path string, body *bytes.Buffer) (*http.Response, error)
+
+	CreateScope(context.Context, *Scope) (created *Scope, adoptedAfter error, err error)
+	PatchScope(context.Context, string, *Scope) error
+	GetScope(context.Context, string) (*Scope, error)
+	DeleteScope(context.Context, string) error
+
+	PatchNRN(context.Context, string, map[string]interface{}) error
+	GetNRN(context.Context, string, []string) (*NRNNamespaces, error)
+
+	CreateApplication(ctx context.Context, application *Application) (created *Application, adoptedAfter error, err error)
+	GetApplication(ctx context.Context, appId string) (*Application, error)
+	PatchApplication(ctx context.Context, appId string, application *Application) error
+	DeleteApplication(ctx context.Context, appId string) error
+
+	CreateCapability(ctx context.Context, capability *CapabilityEntity) (*CapabilityEntity, error)
+	GetCapability(ctx context.Context, capabilityId string) (*CapabilityEntity, error)
+	PatchCapability(ctx context.Context, capabilityId string, capability *CapabilityEntity) error
+	DeleteCapability(ctx context.Context, capabilityId string) error
+
+	CreateDeploymentStrategy(ctx context.Context, ds *DeploymentStrategy) (*DeploymentStrategy, error)
+	GetDeploymentStrategy(ctx context.Context, dsId string) (*DeploymentStrategy, error)
+	PatchDeploymentStrategy(ctx context.Context, dsId string, ds *DeploymentStrategy) error
+	DeleteDeploymentStrategy(ctx context.Context, dsId string) error
+
+	CreateScopeDomain(ctx context.Context, sd *ScopeDomain) (*ScopeDomain, error)
+	GetScopeDomain(ctx context.Context, sdId string) (*ScopeDomain, error)
+	PatchScopeDomain(ctx context.Context, sdId string, sd *ScopeDomain) error
+	DeleteScopeDomain(ctx context.Context, sdId string) error
+
+	CreateService(context.Context, *Service) (created *Service, adoptedAfter error, err error)
+	GetService(context.Context, string) (*Service, error)
+	PatchService(context.Context, string, *Service) error
+	DeleteService(context.Context, string, bool) error
+
+	CreateServiceAction(context.Context, string, *ActionInstance) (*ActionInstance, error)
+	GetServiceAction(context.Context, string, string) (*ActionInstance, error)
+	PatchServiceAction(context.Context, string, string, *ActionInstance) (*ActionInstance, error)
+	ListServiceActions(context.Context, string) ([]*ActionInstance, error)
+	DeleteServiceAction(context.Context, string, string) error
+	CreateLinkAction(context.Context, string, *ActionInstance) (*ActionInstance, error)
+	GetLinkAction(context.Context, string, string) (*ActionInstance, error)
+
+	CreateLink(context.Context, *Link) (created *Link, adoptedAfter error, err error)
+	PatchLink(context.Context, string, *Link) error
+	DeleteLink(context.Context, string) error
+	GetLink(context.Context, string) (*Link, error)
+
+	CreateParameter(ctx context.Context, param *Parameter, importIfCreated bool) (*Parameter, error)
+	PatchParameter(ctx context.Context, parameterId string, param *Parameter) error
+	GetParameter(ctx context.Context, parameterId string, nrn *string) (*Parameter, error)
+	DeleteParameter(ctx context.Context, parameterId string) error
+	GetParameterList(ctx context.Context, nrn string, hideValues ...bool) (*ParameterList, error)
+
+	CreateParameterValue(ctx context.Context, paramId int, paramValue *ParameterValue) (*ParameterValue, error)
+	GetParameterValue(ctx context.Context, parameterId string, parameterValueId string, nrn *string) (*ParameterValue, error)
+	DeleteParameterValue(ctx context.Context, parameterId string, parameterValueId string) error
+
+	CreateApprovalAction(ctx context.Context, action *ApprovalAction) (*ApprovalAction, error)
+	PatchApprovalAction(ctx context.Context, approvalActionId string, action *ApprovalAction) error
+	GetApprovalAction(ctx context.Context, approvalActionId string) (*ApprovalAction, error)
+	DeleteApprovalAction(ctx context.Context, approvalActionId string) error
+
+	CreateApprovalPolicy(ctx context.Context, policy *ApprovalPolicy) (*ApprovalPolicy, error)
+	PatchApprovalPolicy(ctx context.Context, ApprovalPolicyId string, policy *ApprovalPolicy) error
+	GetApprovalPolicy(ctx context.Context, ApprovalPolicyId string) (*ApprovalPolicy, error)
+	DeleteApprovalPolicy(ctx context.Context, ApprovalPolicyId string) error
+
+	AssociatePolicyWithAction(ctx context.Context, approvalActionId, approvalPolicyID string) error
+	DisassociatePolicyFromAction(ctx context.Context, approvalActionId, approvalPolicyID string) error
+
+	CreateEntityHookAction(ctx context.Context, action *EntityHookAction) (*EntityHookAction, error)
+	PatchEntityHookAction(ctx context.Context, entityHookActionId string, action *EntityHookAction) error
+	GetEntityHookAction(ctx context.Context, entityHookActionId string) (*EntityHookAction, error)
+	DeleteEntityHookAction(ctx context.Context, entityHookActionId string) error
+
+	CreateNotificationChannel(ctx context.Context, notification *NotificationChannel) (*NotificationChannel, error)
+	GetNotificationChannel(ctx context.Context, notificationId string) (*NotificationChannel, error)
+	UpdateNotificationChannel(ctx context.Context, notificationId string, notification *NotificationChannel) error
+	DeleteNotificationChannel(ctx context.Context, notificationId string) error
+
+	CreateRuntimeConfiguration(ctx context.Context, rc *RuntimeConfiguration) (*RuntimeConfiguration, error)
+	PatchRuntimeConfiguration(ctx context.Context, runtimeConfigId string, rc *RuntimeConfiguration) error
+	GetRuntimeConfiguration(ctx context.Context, runtimeConfigId string) (*RuntimeConfiguration, error)
+	DeleteRuntimeConfiguration(ctx context.Context, runtimeConfigId string) error
+
+	CreateProviderConfig(ctx context.Context, p *ProviderConfig) (*ProviderConfig, error)
+	GetProviderConfig(ctx context.Context, providerConfigId string) (*ProviderConfig, error)
+	PatchProviderConfig(ctx context.Context, providerConfigId string, p *ProviderConfig) error
+	DeleteProviderConfig(ctx context.Context, providerConfigId string) error
+	GetSpecificationIdFromSlug(ctx context.Context, slug string, nrn string) (string, error)
+	GetSpecificationSlugFromId(ctx context.Context, id string) (string, error)
+
+	GetOrganizationID(ctx context.Context) (string, error)
+	GetAccountBySlug(ctx context.Context, organizationID, slug string) (map[string]interface{}, error)
+	GetNamespaceBySlug(ctx context.Context, accountID, slug string) (map[string]interface{}, error)
+	GetApplicationBySlug(ctx context.Context, namespaceID, slug string) (map[string]interface{}, error)
+	GetScopeBySlug(ctx context.Context, applicationID, slug string) (map[string]interface{}, error)
+
+	CreateDimension(context.Context, *Dimension) (*Dimension, error)
+	GetDimension(context.Context, *string, *string, *string, *string, *string) (*Dimension, error)
+	ListDimensions(ctx context.Context, nrn string) ([]*Dimension, error)
+	UpdateDimension(context.Context, string, *Dimension) error
+	DeleteDimension(context.Context, string) error
+
+	CreateDimensionValue(ctx context.Context, dv *DimensionValue) (*DimensionValue, error)
+	GetDimensionValue(ctx context.Context, dimensionID, valueID int) (*DimensionValue, error)
+	DeleteDimensionValue(ctx context.Context, dimensionID, valueID int) error
+
+	CreateAccount(ctx context.Context, account *Account) (created *Account, adoptedAfter error, err error)
+	GetAccount(ctx context.Context, accountId string) (*Account, error)
+	PatchAccount(ctx context.Context, accountId string, account *Account) error
+	DeleteAccount(ctx context.Context, accountId string) error
+
+	CreateNamespace(ctx context.Context, namespace *Namespace) (created *Namespace, adoptedAfter error, err error)
+	GetNamespace(ctx context.Context, namespaceId string) (*Namespace, error)
+	PatchNamespace(ctx context.Context, namespaceId string, account *Namespace) error
+	DeleteNamespace(ctx context.Context, namespaceId string) error
+
+	CreateMetadataSpecification(ctx context.Context, spec *MetadataSpecification) (*MetadataSpecification, error)
+	GetMetadataSpecification(ctx context.Context, specId string) (*MetadataSpecification, error)
+	UpdateMetadataSpecification(ctx context.Context, specId string, spec *MetadataSpecification) (*MetadataSpecification, error)
+	DeleteMetadataSpecification(ctx context.Context, specId string) error
+
+	CreateServiceSpecification(ctx context.Context, s *ServiceSpecification) (*ServiceSpecification, error)
+	GetServiceSpecification(ctx context.Context, specId string) (*ServiceSpecification, error)
+	PatchServiceSpecification(ctx context.Context, specId string, s *ServiceSpecification) error
+	DeleteServiceSpecification(ctx context.Context, specId string) error
+
+	CreateLinkSpecification(ctx context.Context, s *LinkSpecification) (*LinkSpecification, error)
+	GetLinkSpecification(ctx context.Context, specId string) (*LinkSpecification, error)
+	PatchLinkSpecification(ctx context.Context, specId string, s *LinkSpecification) error
+	DeleteLinkSpecification(ctx context.Context, specId string) error
+
+	CreateActionSpecification(ctx context.Context, s *ActionSpecification) (*ActionSpecification, error)
+	GetActionSpecification(ctx context.Context, specId string, parentType string, parentId string) (*ActionSpecification, error)
+	ListActionSpecifications(ctx context.Context, serviceSpecId string) ([]*ActionSpecification, error)
+	ListLinkActionSpecifications(ctx context.Context, linkSpecId string) ([]*ActionSpecification, error)
+	PatchActionSpecification(ctx context.Context, specId string, s *ActionSpecification, parentType string, parentId string) error
+	DeleteActionSpecification(ctx context.Context, specId string, parentType string, parentId string) error
+
+	GetApiKey(ctx context.Context, apiKeyId int64) (*ApiKey, error)
+	CreateApiKey(ctx context.Context, body *CreateApiKeyRequestBody) (*CreateApiKeyResponseBody, error)
+	PatchApiKey(ctx context.Context, apiKeyId int64, body *PatchApiKeyRequestBody) error
+	DeleteApiKey(ctx context.Context, apiKeyId int64) error
+
+	CreateUser(ctx context.Context, u *User) (*User, error)
+	GetUser(ctx context.Context, userID string) (*User, error)
+	UpdateUser(ctx context.Context, userID string, u *User) error
+	DeleteUser(ctx context.Context, userID string) error
+	LookupUser(ctx context.Context, user *User) (*User, error)
+
+	CreateAuthzGrant(ctx context.Context, grant *AuthzGrant) (*AuthzGrant, error)
+	GetAuthzGrant(ctx context.Context, grantID string) (*AuthzGrant, error)
+	DeleteAuthzGrant(ctx context.Context, grantID string) error
+
+	CreateTechnologyTemplate(ctx context.Context, t *TechnologyTemplate) (*TechnologyTemplate, error)
+	GetTechnologyTemplate(ctx context.Context, templateId string) (*TechnologyTemplate, error)
+	PatchTechnologyTemplate(ctx context.Context, templateId string, t *TechnologyTemplate) error
+	DeleteTechnologyTemplate(ctx context.Context, templateId string) error
+
+	CreateMetadata(ctx context.Context, entity, entityId, metadataType string, m *Metadata) error
+	GetMetadata(ctx context.Context, entity, entityId, metadataType string) (*Metadata, error)
+	UpdateMetadata(ctx context.Context, entity, entityId, metadataType string, m *Metadata) error
+	DeleteMetadata(ctx context.Context, entity, entityId, metadataType string) error
+
+	CreateScopeType(ctx context.Context, s *ScopeType) (*ScopeType, error)
+	GetScopeType(ctx context.Context, scopeTypeId string) (*ScopeType, error)
+	PatchScopeType(ctx context.Context, scopeTypeId string, s *ScopeType) error
+	DeleteScopeType(ctx context.Context, scopeTypeId string) error
+
+	CreateProviderSpecification(ctx context.Context, s *ProviderSpecification) (*ProviderSpecification, error)
+	GetProviderSpecification(ctx context.Context, specId string) (*ProviderSpecification, error)
+	PatchProviderSpecification(ctx context.Context, specId string, s *ProviderSpecification) error
+	DeleteProviderSpecification(ctx context.Context, specId string) error
+
+	RegisterPlatformArtifact(ctx context.Context, r *PlatformArtifactRegistration) (*PlatformArtifactRevision, error)
+	GetPlatformArtifact(ctx context.Context, artifactID string) (*PlatformArtifact, error)
+	GetPlatformArtifactRevision(ctx context.Context, revisionID string) (*PlatformArtifactRevision, error)
+	ListPlatformArtifacts(ctx context.Context, nrn, artifactType string) ([]*PlatformArtifact, error)
+	ListPlatformArtifactRevisions(ctx context.Context, artifactID string) ([]*PlatformArtifactRevision, error)
+
+	UpsertPackage(ctx context.Context, p *PackageUpsert) (*Package, error)
+	GetPackage(ctx context.Context, packageID string) (*Package, error)
+	PatchPackage(ctx context.Context, packageID string, p *PackagePatch) error
+	DeletePackage(ctx context.Context, packageID string) error
+	FindPackage(ctx context.Context, nrn, slug string) (*Package, error)
+	ListPackageRevisions(ctx context.Context, packageID string) ([]*PackageRevision, error)
+	SetPackageTag(ctx context.Context, packageID, name string, body *PackageTagSet) error
+	DeletePackageTag(ctx context.Context, packageID, name string) error
+	GetLatestSnapshotID(ctx context.Context, kind, id string) (string, error)
 }
 
 func (c *NullClient) PrepareQueryString(params map[string]string) string {
@@ -307,110 +268,218 @@ func (c *NullClient) PrepareQueryString(params map[string]string) string {
 	return "?" + query
 }
 
-func (c *NullClient) MakeRequest(method, path string, body *bytes.Buffer) (*http.Response, error) {
-	if err := c.ensureValidToken(); err != nil {
-		return nil, err
+// endpoint builds the absolute URL for an API path. BaseURL carries the scheme
+// and an optional path prefix; clients that only know the host talk HTTPS.
+func (c *NullClient) endpoint(path string) string {
+	if c.BaseURL != "" {
+		return strings.TrimSuffix(c.BaseURL, "/") + path
 	}
+	return fmt.Sprintf("https://%s%s", c.ApiURL, path)
+}
 
-	var req *http.Request
-	var err error
-	url := fmt.Sprintf("https://%s%s", c.ApiURL, path)
-
+func (c *NullClient) MakeRequest(ctx context.Context, method, path string, body *bytes.Buffer) (*http.Response, error) {
+	// Keep a copy of the payload so the request can be rebuilt for retries and
+	// for the replay after a 401.
+	var payload []byte
 	if body != nil {
-		req, err = http.NewRequest(method, url, body)
-	} else {
-		req, err = http.NewRequest(method, url, nil)
+		payload = append([]byte{}, body.Bytes()...)
 	}
 
+	// Drop cached lookups the write may change, both before it is sent and
+	// once it is over, so that no lookup running alongside it can store what
+	// it read in between.
+	if method != http.MethodGet {
+		c.Cache.invalidate(path)
+		defer c.Cache.invalidate(path)
+	}
+
+	token, err := c.validAccessToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	res, err := c.send(ctx, method, path, payload, token)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
 	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token.AccessToken))
 
-	if method != http.MethodGet {
-		return c.Client.Do(req)
+	// The token was rejected before its exp claim says it should (revoked,
+	// clock skew, ...). Drop it, fetch a fresh one and replay the request once.
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	log.Printf("[DEBUG] %s %s was rejected with 401, replaying with a refreshed access token", method, path)
+	c.invalidateToken(token)
+
+	token, err = c.validAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.send(ctx, method, path, payload, token)
+}
+
+func (c *NullClient) send(ctx context.Context, method, path string, payload []byte, token string) (*http.Response, error) {
+	url := c.endpoint(path)
+
+	newRequest := func() (*http.Request, error) {
+		var bodyReader io.Reader
+		if payload != nil {
+			bodyReader = bytes.NewReader(payload)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+		if err != nil {
+			return nil, err
+		}
+
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		return req, nil
+	}
+
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
 	}
 
 	var res *http.Response
-	for attempt := 0; attempt <= defaultGetMaxRetries; attempt++ {
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		release, err := c.Limiter.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		res, err = c.Client.Do(req)
-		if err == nil && !isRetryableStatusCode(res.StatusCode) {
-			return res, nil
+		if err != nil {
+			release()
+		} else {
+			res.Body = &releasingBody{ReadCloser: res.Body, release: release}
 		}
 
-		if attempt == defaultGetMaxRetries {
-			break
+		if attempt >= policy.MaxAttempts || !policy.shouldRetry(method, res, err) {
+			return res, err
 		}
 
+		wait := policy.backoff(attempt, res)
 		if res != nil {
+			log.Printf("[WARN] %s %s got status %d, retry %d/%d in %s", method, path, res.StatusCode, attempt, policy.MaxAttempts-1, wait)
 			io.Copy(io.Discard, res.Body)
 			res.Body.Close()
+		} else {
+			log.Printf("[WARN] %s %s failed (%v), retry %d/%d in %s", method, path, err, attempt, policy.MaxAttempts-1, wait)
 		}
 
-		log.Printf("[WARN] GET %s retry %d/%d after error/status (err=%v)", path, attempt+1, defaultGetMaxRetries, err)
-		time.Sleep(defaultGetRetryDelay)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 	}
-
-	return res, err
 }
 
-func (c *NullClient) ensureValidToken() error {
+func (c *NullClient) validAccessToken(ctx context.Context) (string, error) {
 	c.tokenMutex.Lock()
 	defer c.tokenMutex.Unlock()
 
-	if c.Token.AccessToken == "" {
-		diag := c.getToken()
-		if diag != nil {
-			return fmt.Errorf("%s", diag[0].Summary)
+	if c.TokenSource != nil {
+		token, err := c.TokenSource.Token(ctx)
+		if err != nil {
+			return "", err
 		}
+		c.Token = token
+		return c.Token.AccessToken, nil
 	}
 
-	return nil
+	if c.Token.AccessToken != "" && !c.Token.expiresWithin(tokenRefreshWindow) {
+		return c.Token.AccessToken, nil
+	}
+
+	if err := c.refreshToken(ctx); err != nil {
+		return "", err
+	}
+
+	return c.Token.AccessToken, nil
 }
 
-func (c *NullClient) getToken() diag.Diagnostics {
-	treq := TokenRequest{
-		Apikey: c.ApiKey,
+// invalidateToken forgets the access token after the API rejected it. It is a
+// no-op when another request already replaced the rejected token, so a burst of
+// 401s only triggers one refresh.
+func (c *NullClient) invalidateToken(rejected string) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.Token.AccessToken == rejected {
+		c.Token.AccessToken = ""
 	}
+	if c.TokenSource != nil {
+		c.TokenSource.Invalidate(rejected)
+	}
+}
 
+// refreshToken replaces the current token, preferring the refresh token when
+// one was issued and falling back to exchanging the API key. Must be called
+// with tokenMutex held.
+func (c *NullClient) refreshToken(ctx context.Context) error {
+	if c.Token.RefreshToken != "" {
+		err := c.getToken(ctx, &TokenRequest{RefreshToken: c.Token.RefreshToken})
+		if err == nil {
+			return nil
+		}
+		log.Printf("[DEBUG] refreshing the access token with the refresh token failed, falling back to the API key: %v", err)
+	}
+
+	return c.getToken(ctx, &TokenRequest{Apikey: c.ApiKey})
+}
+
+func (c *NullClient) getToken(ctx context.Context, treq *TokenRequest) error {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(treq)
+	err := json.NewEncoder(&buf).Encode(*treq)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	r, err := http.NewRequest("POST", fmt.Sprintf("https://%s%s", c.ApiURL, TOKEN_PATH), &buf)
+	r, err := http.NewRequestWithContext(ctx, "POST", c.endpoint(TOKEN_PATH), &buf)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	r.Header.Add("Content-Type", "application/json")
 
 	res, err := c.Client.Do(r)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return diag.FromErr(fmt.Errorf("failed to get access token, got %d", res.StatusCode))
+	if err := checkResponse(res, "access token", "get"); err != nil {
+		return err
 	}
 
 	tRes := &Token{}
 	derr := json.NewDecoder(res.Body).Decode(tRes)
 
 	if derr != nil {
-		return diag.FromErr(derr)
+		return derr
 	}
 
 	if tRes.AccessToken == "" {
-		return diag.FromErr(fmt.Errorf("no access token for null platform token rsp is: %s", tRes))
+		return fmt.Errorf("no access token in the nullplatform token response")
+	}
+
+	// A refresh grant may not rotate the refresh token, keep using the old one.
+	if tRes.RefreshToken == "" {
+		tRes.RefreshToken = c.Token.RefreshToken
 	}
 
 	c.Token = (*tRes)
@@ -418,57 +487,28 @@ func (c *NullClient) getToken() diag.Diagnostics {
 	return nil
 }
 
-func (c *NullClient) GetOrganizationIDFromToken() (string, error) {
-	c.cachedOrgIDLock.RLock()
-	if c.cachedOrgID != "" {
-		defer c.cachedOrgIDLock.RUnlock()
-		return c.cachedOrgID, nil
-	}
-	c.cachedOrgIDLock.RUnlock()
-
-	if err := c.ensureValidToken(); err != nil {
-		return "", fmt.Errorf("failed to ensure valid token: %v", err)
-	}
-
-	c.cachedOrgIDLock.Lock()
-	defer c.cachedOrgIDLock.Unlock()
-
-	if c.cachedOrgID != "" {
-		return c.cachedOrgID, nil
+// expiresWithin reports whether the token expires within the given window,
+// going by Expiry when it is set and by the exp claim otherwise.
+func (t Token) expiresWithin(window time.Duration) bool {
+	if !t.Expiry.IsZero() {
+		return time.Until(t.Expiry) < window
 	}
+	return tokenExpiresWithin(t.AccessToken, window)
+}
 
-	token, _, err := new(jwt.Parser).ParseUnverified(c.Token.AccessToken, jwt.MapClaims{})
+// tokenExpiresWithin reports whether the token's exp claim falls within the
+// given window. Tokens that cannot be parsed or carry no exp claim are never
+// considered expired here; a 401 from the API still triggers a refresh.
+func tokenExpiresWithin(accessToken string, window time.Duration) bool {
+	token, _, err := new(jwt.Parser).ParseUnverified(accessToken, jwt.MapClaims{})
 	if err != nil {
-		return "", fmt.Errorf("failed to parse token: %v", err)
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return "", fmt.Errorf("invalid token claims type: %T", token.Claims)
-	}
-
-	groups, ok := claims["cognito:groups"]
-	if !ok {
-		return "", fmt.Errorf("claim was not found")
-	}
-
-	groupsSlice, ok := groups.([]interface{})
-	if !ok {
-		return "", fmt.Errorf("cognito:groups is not a slice: %T", groups)
+		return false
 	}
 
-	for _, group := range groupsSlice {
-		groupStr, ok := group.(string)
-		if !ok {
-			log.Printf("Unexpected group type: %T", group)
-			continue
-		}
-		if strings.HasPrefix(groupStr, "@nullplatform/organization=") {
-			orgID := strings.TrimPrefix(groupStr, "@nullplatform/organization=")
-			c.cachedOrgID = orgID
-			return orgID, nil
-		}
+	exp, err := token.Claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return false
 	}
 
-	return "", fmt.Errorf("organization ID not found in token")
+	return time.Until(exp.Time) < window
 }
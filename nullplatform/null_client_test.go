@@ -2,11 +2,20 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
 )
 
 func newTestClient(server *httptest.Server) *NullClient {
@@ -16,6 +25,12 @@ func newTestClient(server *httptest.Server) *NullClient {
 		Client: server.Client(),
 		ApiURL: host,
 		Token:  Token{AccessToken: "test-token"},
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts:          defaultRetryMaxAttempts,
+			MinBackoff:           time.Millisecond,
+			MaxBackoff:           5 * time.Millisecond,
+			RetryableStatusCodes: defaultRetryableStatusCodes,
+		},
 	}
 }
 
@@ -73,7 +88,7 @@ func TestMakeRequest_ContentTypeOnlySetWhenBodyPresent(t *testing.T) {
 			defer server.Close()
 
 			client := newTestClient(server)
-			_, err := client.MakeRequest(tt.method, "/test", tt.body)
+			_, err := client.MakeRequest(context.Background(), tt.method, "/test", tt.body)
 			if err != nil {
 				t.Fatalf("MakeRequest returned unexpected error: %v", err)
 			}
@@ -87,62 +102,86 @@ func TestMakeRequest_ContentTypeOnlySetWhenBodyPresent(t *testing.T) {
 	}
 }
 
-func TestMakeRequest_RetryOnGet(t *testing.T) {
+func TestMakeRequest_Retry(t *testing.T) {
 	tests := []struct {
-		name             string
-		method           string
+		name              string
+		method            string
 		failuresBefore2xx int32
-		respondStatus    int
-		wantAttempts     int32
-		wantStatus       int
+		respondStatus     int
+		wantAttempts      int32
+		wantStatus        int
 	}{
 		{
-			name:             "GET retries on 503 until success",
-			method:           "GET",
+			name:              "GET retries on 503 until success",
+			method:            "GET",
 			failuresBefore2xx: 2,
-			respondStatus:    http.StatusServiceUnavailable,
-			wantAttempts:     3,
-			wantStatus:       http.StatusOK,
+			respondStatus:     http.StatusServiceUnavailable,
+			wantAttempts:      3,
+			wantStatus:        http.StatusOK,
 		},
 		{
-			name:             "GET stops after max retries",
-			method:           "GET",
+			name:              "GET stops after max retries",
+			method:            "GET",
 			failuresBefore2xx: 10,
-			respondStatus:    http.StatusBadGateway,
-			wantAttempts:     4,
-			wantStatus:       http.StatusBadGateway,
+			respondStatus:     http.StatusBadGateway,
+			wantAttempts:      4,
+			wantStatus:        http.StatusBadGateway,
 		},
 		{
-			name:             "GET does not retry on 200",
-			method:           "GET",
+			name:              "GET does not retry on 200",
+			method:            "GET",
 			failuresBefore2xx: 0,
-			respondStatus:    http.StatusOK,
-			wantAttempts:     1,
-			wantStatus:       http.StatusOK,
+			respondStatus:     http.StatusOK,
+			wantAttempts:      1,
+			wantStatus:        http.StatusOK,
 		},
 		{
-			name:             "GET does not retry on 404",
-			method:           "GET",
+			name:              "GET does not retry on 404",
+			method:            "GET",
 			failuresBefore2xx: 10,
-			respondStatus:    http.StatusNotFound,
-			wantAttempts:     1,
-			wantStatus:       http.StatusNotFound,
+			respondStatus:     http.StatusNotFound,
+			wantAttempts:      1,
+			wantStatus:        http.StatusNotFound,
+		},
+		{
+			name:              "DELETE retries on 504 like any idempotent method",
+			method:            "DELETE",
+			failuresBefore2xx: 1,
+			respondStatus:     http.StatusGatewayTimeout,
+			wantAttempts:      2,
+			wantStatus:        http.StatusOK,
 		},
 		{
-			name:             "DELETE never retries on 503",
-			method:           "DELETE",
+			name:              "POST never retries on 502",
+			method:            "POST",
 			failuresBefore2xx: 10,
-			respondStatus:    http.StatusServiceUnavailable,
-			wantAttempts:     1,
-			wantStatus:       http.StatusServiceUnavailable,
+			respondStatus:     http.StatusBadGateway,
+			wantAttempts:      1,
+			wantStatus:        http.StatusBadGateway,
 		},
 		{
-			name:             "POST never retries on 502",
-			method:           "POST",
+			name:              "PATCH never retries on 504",
+			method:            "PATCH",
 			failuresBefore2xx: 10,
-			respondStatus:    http.StatusBadGateway,
-			wantAttempts:     1,
-			wantStatus:       http.StatusBadGateway,
+			respondStatus:     http.StatusGatewayTimeout,
+			wantAttempts:      1,
+			wantStatus:        http.StatusGatewayTimeout,
+		},
+		{
+			name:              "POST retries on 429 because the request was not processed",
+			method:            "POST",
+			failuresBefore2xx: 2,
+			respondStatus:     http.StatusTooManyRequests,
+			wantAttempts:      3,
+			wantStatus:        http.StatusOK,
+		},
+		{
+			name:              "PATCH retries on 503 because the request was not processed",
+			method:            "PATCH",
+			failuresBefore2xx: 1,
+			respondStatus:     http.StatusServiceUnavailable,
+			wantAttempts:      2,
+			wantStatus:        http.StatusOK,
 		},
 	}
 
@@ -161,7 +200,168 @@ func TestMakeRequest_RetryOnGet(t *testing.T) {
 			defer server.Close()
 
 			client := newTestClient(server)
-			res, err := client.MakeRequest(tt.method, "/test", nil)
+			res, err := client.MakeRequest(context.Background(), tt.method, "/test", nil)
+			if err != nil {
+				t.Fatalf("MakeRequest returned unexpected error: %v", err)
+			}
+			defer res.Body.Close()
+
+			if got := atomic.LoadInt32(&attempts); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func signedTestToken(t *testing.T, expiresAt time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp": expiresAt.Unix(),
+	}).SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatalf("signing test token: %v", err)
+	}
+	return token
+}
+
+func TestMakeRequest_RefreshesExpiringToken(t *testing.T) {
+	freshToken := signedTestToken(t, time.Now().Add(time.Hour))
+
+	var tokenRequests []TokenRequest
+	var gotAuthorization string
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == TOKEN_PATH {
+			var treq TokenRequest
+			_ = json.NewDecoder(r.Body).Decode(&treq)
+			tokenRequests = append(tokenRequests, treq)
+			_ = json.NewEncoder(w).Encode(Token{AccessToken: freshToken})
+			return
+		}
+		gotAuthorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.ApiKey = "api-key"
+	client.Token = Token{
+		AccessToken:  signedTestToken(t, time.Now().Add(30*time.Second)),
+		RefreshToken: "refresh-token",
+	}
+
+	res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("MakeRequest returned unexpected error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if len(tokenRequests) != 1 {
+		t.Fatalf("token requests = %d, want 1", len(tokenRequests))
+	}
+	if tokenRequests[0].RefreshToken != "refresh-token" || tokenRequests[0].Apikey != "" {
+		t.Errorf("token request = %+v, want a refresh token grant", tokenRequests[0])
+	}
+	if gotAuthorization != "Bearer "+freshToken {
+		t.Errorf("Authorization = %q, want the refreshed token", gotAuthorization)
+	}
+	if client.Token.RefreshToken != "refresh-token" {
+		t.Errorf("refresh token = %q, want it kept when the response does not rotate it", client.Token.RefreshToken)
+	}
+}
+
+func TestMakeRequest_FallsBackToAPIKeyWhenRefreshFails(t *testing.T) {
+	var tokenRequests []TokenRequest
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == TOKEN_PATH {
+			var treq TokenRequest
+			_ = json.NewDecoder(r.Body).Decode(&treq)
+			tokenRequests = append(tokenRequests, treq)
+			if treq.RefreshToken != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(Token{AccessToken: "from-api-key"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.ApiKey = "api-key"
+	client.Token = Token{
+		AccessToken:  signedTestToken(t, time.Now().Add(-time.Minute)),
+		RefreshToken: "stale-refresh-token",
+	}
+
+	res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("MakeRequest returned unexpected error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if len(tokenRequests) != 2 {
+		t.Fatalf("token requests = %d, want 2", len(tokenRequests))
+	}
+	if tokenRequests[1].Apikey != "api-key" {
+		t.Errorf("fallback token request = %+v, want the API key", tokenRequests[1])
+	}
+	if client.Token.AccessToken != "from-api-key" {
+		t.Errorf("access token = %q, want from-api-key", client.Token.AccessToken)
+	}
+}
+
+func TestMakeRequest_ReplaysOnceAfterUnauthorized(t *testing.T) {
+	tests := []struct {
+		name         string
+		acceptsFresh bool
+		wantAttempts int32
+		wantStatus   int
+	}{
+		{
+			name:         "replayed request succeeds with the refreshed token",
+			acceptsFresh: true,
+			wantAttempts: 2,
+			wantStatus:   http.StatusOK,
+		},
+		{
+			name:         "a second 401 is returned to the caller",
+			acceptsFresh: false,
+			wantAttempts: 2,
+			wantStatus:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			var gotBodies []string
+
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == TOKEN_PATH {
+					_ = json.NewEncoder(w).Encode(Token{AccessToken: "fresh-token"})
+					return
+				}
+				atomic.AddInt32(&attempts, 1)
+				body, _ := io.ReadAll(r.Body)
+				gotBodies = append(gotBodies, string(body))
+				if tt.acceptsFresh && r.Header.Get("Authorization") == "Bearer fresh-token" {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(http.StatusUnauthorized)
+			}))
+			defer server.Close()
+
+			client := newTestClient(server)
+			client.ApiKey = "api-key"
+
+			res, err := client.MakeRequest(context.Background(), "POST", "/test", bytes.NewBufferString(`{"key":"value"}`))
 			if err != nil {
 				t.Fatalf("MakeRequest returned unexpected error: %v", err)
 			}
@@ -173,6 +373,155 @@ func TestMakeRequest_RetryOnGet(t *testing.T) {
 			if res.StatusCode != tt.wantStatus {
 				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
 			}
+			for i, body := range gotBodies {
+				if body != `{"key":"value"}` {
+					t.Errorf("attempt %d body = %q, want the original payload", i+1, body)
+				}
+			}
 		})
 	}
 }
+
+func TestMakeRequest_ConcurrentRequestsShareOneRefresh(t *testing.T) {
+	var tokenRequests int32
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == TOKEN_PATH {
+			atomic.AddInt32(&tokenRequests, 1)
+			_ = json.NewEncoder(w).Encode(Token{AccessToken: signedTestToken(t, time.Now().Add(time.Hour))})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.ApiKey = "api-key"
+	client.Token = Token{AccessToken: signedTestToken(t, time.Now().Add(-time.Minute))}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+			if err != nil {
+				t.Errorf("MakeRequest returned unexpected error: %v", err)
+				return
+			}
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&tokenRequests); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+}
+
+func TestMakeRequest_RetryHonoursRetryAfter(t *testing.T) {
+	var attempts int32
+	var firstAttempt, secondAttempt time.Time
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		secondAttempt = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.RetryPolicy.MaxBackoff = 5 * time.Second
+
+	res, err := client.MakeRequest(context.Background(), "POST", "/test", bytes.NewBufferString(`{}`))
+	if err != nil {
+		t.Fatalf("MakeRequest returned unexpected error: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", res.StatusCode)
+	}
+	if waited := secondAttempt.Sub(firstAttempt); waited < time.Second {
+		t.Errorf("waited %s between attempts, want at least the 1s Retry-After", waited)
+	}
+}
+
+func TestMakeRequest_PostRetriesWhenConnectionIsRefused(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	client := newTestClient(server)
+	server.Close()
+
+	var dialAttempts int32
+	transport := client.Client.Transport.(*http.Transport).Clone()
+	dial := transport.DialContext
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		atomic.AddInt32(&dialAttempts, 1)
+		return dial(ctx, network, addr)
+	}
+	client.Client = &http.Client{Transport: transport}
+
+	_, err := client.MakeRequest(context.Background(), "POST", "/test", bytes.NewBufferString(`{}`))
+	if err == nil {
+		t.Fatal("expected an error from a closed server")
+	}
+	if got := atomic.LoadInt32(&dialAttempts); got != defaultRetryMaxAttempts {
+		t.Errorf("dial attempts = %d, want %d", got, defaultRetryMaxAttempts)
+	}
+}
+
+func TestMakeRequest_StopsWhenContextIsCancelled(t *testing.T) {
+	var attempts int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.RetryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.MakeRequest(ctx, "GET", "/test", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("MakeRequest kept waiting %s after the context expired", elapsed)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
+
+func TestMakeRequest_CancelsInFlightRequest(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-unblock:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	client := newTestClient(server)
+	client.RetryPolicy.MaxAttempts = 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := client.MakeRequest(ctx, "POST", "/test", bytes.NewBufferString(`{}`))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,84 @@
+package nullplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const tokenOrganizationGroupPrefix = "@nullplatform/organization="
+
+// GetOrganizationID returns the organization every NRN is built under. It is
+// resolved once per provider, from the first of:
+//
+//   - the organization_id attribute, or the organization of the profile's nrn;
+//   - the access token, when its cognito:groups claim names exactly one
+//     organization.
+func (c *NullClient) GetOrganizationID(ctx context.Context) (string, error) {
+	c.orgIDMutex.Lock()
+	defer c.orgIDMutex.Unlock()
+
+	if c.cachedOrgID != "" {
+		return c.cachedOrgID, nil
+	}
+	if c.OrganizationID != "" {
+		c.cachedOrgID = c.OrganizationID
+		return c.cachedOrgID, nil
+	}
+
+	accessToken, err := c.validAccessToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to ensure valid token: %v", err)
+	}
+
+	tokenOrgIDs := organizationIDsFromToken(accessToken)
+	if len(tokenOrgIDs) == 1 {
+		c.cachedOrgID = tokenOrgIDs[0]
+		return c.cachedOrgID, nil
+	}
+
+	tokenDetail := "names no organization"
+	if len(tokenOrgIDs) > 1 {
+		tokenDetail = fmt.Sprintf("names several organizations (%s)", strings.Join(tokenOrgIDs, ", "))
+	}
+	return "", fmt.Errorf("could not determine the organization: organization_id, NULLPLATFORM_ORGANIZATION_ID and the nrn of the profile "+
+		"are not set, and the cognito:groups claim of the access token %s. "+
+		"Set organization_id (or NULLPLATFORM_ORGANIZATION_ID) in the provider configuration", tokenDetail)
+}
+
+// organizationIDsFromToken returns the organizations named in the
+// cognito:groups claim of the token, parsed without verifying it. Tokens that
+// are not JWTs or carry no such claim yield none.
+func organizationIDsFromToken(accessToken string) []string {
+	token, _, err := new(jwt.Parser).ParseUnverified(accessToken, jwt.MapClaims{})
+	if err != nil {
+		return nil
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil
+	}
+
+	groups, ok := claims["cognito:groups"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var orgIDs []string
+	seen := map[string]bool{}
+	for _, group := range groups {
+		groupStr, ok := group.(string)
+		if !ok || !strings.HasPrefix(groupStr, tokenOrganizationGroupPrefix) {
+			continue
+		}
+		orgID := strings.TrimPrefix(groupStr, tokenOrganizationGroupPrefix)
+		if !seen[orgID] {
+			seen[orgID] = true
+			orgIDs = append(orgIDs, orgID)
+		}
+	}
+	return orgIDs
+}
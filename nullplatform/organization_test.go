@@ -0,0 +1,87 @@
+package nullplatform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func groupsTestToken(t *testing.T, groups ...string) string {
+	t.Helper()
+	claimGroups := make([]interface{}, len(groups))
+	for i, group := range groups {
+		claimGroups[i] = group
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"cognito:groups": claimGroups,
+	}).SignedString([]byte("test-secret"))
+	if err != nil {
+		t.Fatalf("signing test token: %v", err)
+	}
+	return token
+}
+
+func TestGetOrganizationID(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		token      string
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "configured organization wins",
+			configured: "7",
+			token:      groupsTestToken(t, "@nullplatform/organization=1"),
+			want:       "7",
+		},
+		{
+			name:  "single organization in the token",
+			token: groupsTestToken(t, "admins", "@nullplatform/organization=1"),
+			want:  "1",
+		},
+		{
+			name:    "several organizations in the token",
+			token:   groupsTestToken(t, "@nullplatform/organization=1", "@nullplatform/organization=2"),
+			wantErr: "names several organizations (1, 2)",
+		},
+		{
+			name:    "token from another issuer",
+			token:   "opaque-token",
+			wantErr: "names no organization",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s", r.URL.Path)
+			}))
+			defer server.Close()
+
+			client := newTestClient(server)
+			client.Token = Token{AccessToken: tt.token}
+			client.OrganizationID = tt.configured
+
+			for i := 0; i < 2; i++ {
+				got, err := client.GetOrganizationID(context.Background())
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "NULLPLATFORM_ORGANIZATION_ID and the nrn of the profile") {
+						t.Fatalf("GetOrganizationID() error = %v, want it to mention %q and every source tried", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.want {
+					t.Errorf("GetOrganizationID() = %s, want %s", got, tt.want)
+				}
+			}
+		})
+	}
+}
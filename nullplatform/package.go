@@ -2,10 +2,10 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 )
 
 const PACKAGE_PATH = "/packages"
@@ -89,36 +89,28 @@ type packageListResponse struct {
 	Results []*Package `json:"results"`
 }
 
-type packageRevisionListResponse struct {
-	Results []*PackageRevision `json:"results"`
-}
-
-func (c *NullClient) UpsertPackage(p *PackageUpsert) (*Package, error) {
+func (c *NullClient) UpsertPackage(ctx context.Context, p *PackageUpsert) (*Package, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(p); err != nil {
 		return nil, fmt.Errorf("error encoding package: %v", err)
 	}
 
-	res, err := c.MakeRequest("PUT", PACKAGE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "PUT", PACKAGE_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error making PUT request: %v", err)
 	}
 	defer res.Body.Close()
 
+	// 201 on create, 200 on publish over an existing package.
+	if err := checkResponse(res, "package", "upsert"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	// 201 on create, 200 on publish over an existing package.
-	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
-			return nil, fmt.Errorf("API error publishing package: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error publishing package, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	pkg := &Package{}
 	if err := json.Unmarshal(body, pkg); err != nil {
 		return nil, fmt.Errorf("error decoding package: %v", err)
@@ -127,10 +119,10 @@ func (c *NullClient) UpsertPackage(p *PackageUpsert) (*Package, error) {
 	return pkg, nil
 }
 
-func (c *NullClient) GetPackage(packageID string) (*Package, error) {
+func (c *NullClient) GetPackage(ctx context.Context, packageID string) (*Package, error) {
 	path := fmt.Sprintf("%s/%s", PACKAGE_PATH, packageID)
 
-	body, err := c.getJSON(path, "package")
+	body, err := c.getJSON(ctx, path, "package")
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +135,7 @@ func (c *NullClient) GetPackage(packageID string) (*Package, error) {
 	return pkg, nil
 }
 
-func (c *NullClient) PatchPackage(packageID string, p *PackagePatch) error {
+func (c *NullClient) PatchPackage(ctx context.Context, packageID string, p *PackagePatch) error {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(p); err != nil {
 		return fmt.Errorf("error encoding package patch: %v", err)
@@ -151,31 +143,18 @@ func (c *NullClient) PatchPackage(packageID string, p *PackagePatch) error {
 
 	path := fmt.Sprintf("%s/%s", PACKAGE_PATH, packageID)
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("error making PATCH request: %v", err)
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
-			return fmt.Errorf("API error patching package: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return fmt.Errorf("error patching package, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
-	return nil
+	return checkResponse(res, "package", "update")
 }
 
 // SetPackageTag points a user tag at a revision (create or move). The body
 // carries either a revision id or a published version.
-func (c *NullClient) SetPackageTag(packageID, name string, body *PackageTagSet) error {
+func (c *NullClient) SetPackageTag(ctx context.Context, packageID, name string, body *PackageTagSet) error {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(body); err != nil {
 		return fmt.Errorf("error encoding package tag: %v", err)
@@ -183,85 +162,58 @@ func (c *NullClient) SetPackageTag(packageID, name string, body *PackageTagSet)
 
 	path := fmt.Sprintf("%s/%s/tags/%s", PACKAGE_PATH, packageID, name)
 
-	res, err := c.MakeRequest("PUT", path, &buf)
+	res, err := c.MakeRequest(ctx, "PUT", path, &buf)
 	if err != nil {
 		return fmt.Errorf("error making PUT request: %v", err)
 	}
 	defer res.Body.Close()
 
-	resBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(resBody, &errResp); err == nil && errResp.Message != "" {
-			return fmt.Errorf("API error setting package tag '%s': %s (Code: %s)", name, errResp.Message, errResp.Code)
-		}
-		return fmt.Errorf("error setting package tag '%s', got status code: %d, body: %s", name, res.StatusCode, string(resBody))
-	}
-
-	return nil
+	return checkResponse(res, "package tag", "set")
 }
 
 // DeletePackageTag removes a user tag pointer; the revision is untouched.
-func (c *NullClient) DeletePackageTag(packageID, name string) error {
+func (c *NullClient) DeletePackageTag(ctx context.Context, packageID, name string) error {
 	path := fmt.Sprintf("%s/%s/tags/%s", PACKAGE_PATH, packageID, name)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %v", err)
 	}
 	defer res.Body.Close()
 
-	resBody, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(resBody, &errResp); err == nil && errResp.Message != "" {
-			return fmt.Errorf("API error deleting package tag '%s': %s (Code: %s)", name, errResp.Message, errResp.Code)
+	if err := checkResponse(res, "package tag", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting package tag '%s', got status code: %d, body: %s", name, res.StatusCode, string(resBody))
 	}
 
 	return nil
 }
 
-func (c *NullClient) DeletePackage(packageID string) error {
+func (c *NullClient) DeletePackage(ctx context.Context, packageID string) error {
 	path := fmt.Sprintf("%s/%s", PACKAGE_PATH, packageID)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %v", err)
 	}
 	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return fmt.Errorf("error reading response body: %v", err)
-	}
-
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
-			return fmt.Errorf("API error deleting package: %s (Code: %s)", errResp.Message, errResp.Code)
+	if err := checkResponse(res, "package", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting package, got status code: %d, body: %s", res.StatusCode, string(body))
 	}
 
 	return nil
 }
 
 // FindPackage resolves a package by its natural key (nrn, slug).
-func (c *NullClient) FindPackage(nrn, slug string) (*Package, error) {
+func (c *NullClient) FindPackage(ctx context.Context, nrn, slug string) (*Package, error) {
 	params := map[string]string{"nrn": nrn, "slug": slug}
 	path := fmt.Sprintf("%s%s", PACKAGE_PATH, c.PrepareQueryString(params))
 
-	body, err := c.getJSON(path, "packages")
+	body, err := c.getJSON(ctx, path, "packages")
 	if err != nil {
 		return nil, err
 	}
@@ -278,18 +230,8 @@ func (c *NullClient) FindPackage(nrn, slug string) (*Package, error) {
 	return response.Results[0], nil
 }
 
-func (c *NullClient) ListPackageRevisions(packageID string) ([]*PackageRevision, error) {
+func (c *NullClient) ListPackageRevisions(ctx context.Context, packageID string) ([]*PackageRevision, error) {
 	path := fmt.Sprintf("%s/%s/revisions", PACKAGE_PATH, packageID)
 
-	body, err := c.getJSON(path, "package revisions")
-	if err != nil {
-		return nil, err
-	}
-
-	response := &packageRevisionListResponse{}
-	if err := json.Unmarshal(body, response); err != nil {
-		return nil, fmt.Errorf("error decoding package revision list: %v", err)
-	}
-
-	return response.Results, nil
+	return listAll[*PackageRevision](ctx, c, path, "package revisions")
 }
@@ -0,0 +1,148 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultPageSize is the limit requested from list endpoints when the
+// provider block does not set page_size.
+const defaultPageSize = 100
+
+// listPage is the envelope list endpoints answer with.
+type listPage[T any] struct {
+	Paging  *Paging `json:"paging,omitempty"`
+	Results []T     `json:"results"`
+}
+
+func (c *NullClient) pageSize() int {
+	if c.PageSize > 0 {
+		return c.PageSize
+	}
+	return defaultPageSize
+}
+
+// paginate walks the offset/limit pages of a list endpoint and yields its
+// results one by one, requesting the next page only once the current one has
+// been consumed. path may already carry a query string; offset and limit are
+// set on top of it. Iteration stops after the first error, which is yielded
+// with the zero value of T.
+//
+// The API may cap the limit below the one requested: when a page reports the
+// limit it applied, that limit is what a full page is measured against, and
+// the next page starts after the results actually received. The walk ends on
+// an empty or short page. It also ends when the API returned more results
+// than the limit or answered for another offset than asked, which is how
+// endpoints that do not paginate respond, so that they are read once rather
+// than forever.
+func paginate[T any](ctx context.Context, c *NullClient, path, apiType string) iter.Seq2[T, error] {
+	return paginateWith[T](ctx, c, path, apiType, func(ctx context.Context, path string) (*http.Response, error) {
+		return c.MakeRequest(ctx, http.MethodGet, path, nil)
+	})
+}
+
+func paginateWith[T any](ctx context.Context, c *NullClient, path, apiType string, get func(context.Context, string) (*http.Response, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		requested := c.pageSize()
+
+		for offset := 0; ; {
+			page, err := getPage[T](ctx, get, path, apiType, offset, requested)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			limit := requested
+			if page.Paging != nil {
+				if page.Paging.Offset != offset {
+					// An endpoint that ignores offset answers with the
+					// first page again, which was already read.
+					if offset > 0 {
+						return
+					}
+				} else if page.Paging.Limit > 0 {
+					limit = page.Paging.Limit
+				}
+			}
+
+			for _, item := range page.Results {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			received := len(page.Results)
+			if received == 0 || received != limit || (page.Paging != nil && page.Paging.Offset != offset) {
+				return
+			}
+			offset += received
+		}
+	}
+}
+
+// listAll collects every result of a list endpoint across its pages.
+func listAll[T any](ctx context.Context, c *NullClient, path, apiType string) ([]T, error) {
+	return collect(paginate[T](ctx, c, path, apiType))
+}
+
+// listAllCached is listAll with every page read through the read cache.
+func listAllCached[T any](ctx context.Context, c *NullClient, path, apiType string) ([]T, error) {
+	return collect(paginateWith[T](ctx, c, path, apiType, c.getCached))
+}
+
+func collect[T any](items iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range items {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+func getPage[T any](ctx context.Context, get func(context.Context, string) (*http.Response, error), path, apiType string, offset, limit int) (*listPage[T], error) {
+	pagePath, err := withPaging(path, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := get(ctx, pagePath)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if err := checkResponse(res, apiType, "list"); err != nil {
+		return nil, err
+	}
+
+	page := &listPage[T]{}
+	if err := json.NewDecoder(res.Body).Decode(page); err != nil {
+		return nil, fmt.Errorf("failed to list %s: decoding page at offset %d: %w", apiType, offset, err)
+	}
+
+	return page, nil
+}
+
+// withPaging sets offset and limit on the query string of path, replacing any
+// value already there.
+func withPaging(path string, offset, limit int) (string, error) {
+	base, rawQuery, _ := strings.Cut(path, "?")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", fmt.Errorf("invalid query string in %s: %w", path, err)
+	}
+	query.Set("offset", strconv.Itoa(offset))
+	query.Set("limit", strconv.Itoa(limit))
+
+	return base + "?" + query.Encode(), nil
+}
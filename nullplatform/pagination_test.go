@@ -0,0 +1,240 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagedServer serves total items named item-0..item-N from any path,
+// honoring offset and limit, and counts the requests it received.
+func newPagedServer(t *testing.T, total int, extra func(item map[string]interface{}, i int)) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+		if err != nil {
+			t.Errorf("request without offset: %s", r.URL)
+		}
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			t.Errorf("request without limit: %s", r.URL)
+		}
+
+		results := []map[string]interface{}{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			item := map[string]interface{}{"id": strconv.Itoa(i), "name": fmt.Sprintf("item-%d", i)}
+			if extra != nil {
+				extra(item, i)
+			}
+			results = append(results, item)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"paging":  map[string]int{"offset": offset, "limit": limit},
+			"results": results,
+		})
+	}))
+	return server, &requests
+}
+
+func TestListAll_WalksEveryPage(t *testing.T) {
+	tests := []struct {
+		total        int
+		wantRequests int32
+	}{
+		{total: 0, wantRequests: 1},
+		{total: 2, wantRequests: 1},
+		{total: 3, wantRequests: 2},
+		{total: 7, wantRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.total), func(t *testing.T) {
+			server, requests := newPagedServer(t, tt.total, nil)
+			defer server.Close()
+
+			client := newTestClient(server)
+			client.PageSize = 3
+
+			items, err := listAll[*ActionSpecification](context.Background(), client, "/service_specification/1/action_specification", "action specifications")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != tt.total {
+				t.Fatalf("got %d items, want %d", len(items), tt.total)
+			}
+			for i, item := range items {
+				if item.Name != fmt.Sprintf("item-%d", i) {
+					t.Errorf("items[%d] = %s, want them in API order", i, item.Name)
+				}
+			}
+			if got := atomic.LoadInt32(requests); got != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestListAll_FollowsTheLimitTheAPIApplied(t *testing.T) {
+	const total, maxLimit = 7, 2
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit > maxLimit {
+			limit = maxLimit
+		}
+
+		results := []map[string]interface{}{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			results = append(results, map[string]interface{}{"id": strconv.Itoa(i), "name": fmt.Sprintf("item-%d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"paging":  map[string]int{"offset": offset, "limit": limit},
+			"results": results,
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 5
+
+	items, err := listAll[*ActionSpecification](context.Background(), client, "/service_specification/1/action_specification", "action specifications")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != total {
+		t.Fatalf("got %d items, want all %d", len(items), total)
+	}
+	for i, item := range items {
+		if item.Name != fmt.Sprintf("item-%d", i) {
+			t.Errorf("items[%d] = %s, want them in API order", i, item.Name)
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != 4 {
+		t.Errorf("sent %d requests, want 4", got)
+	}
+}
+
+func TestListAll_KeepsQueryAndStopsOnUnpaginatedEndpoints(t *testing.T) {
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if got := r.URL.Query().Get("nrn"); got != "organization=1:account=2" {
+			t.Errorf("nrn = %q, want the filter kept", got)
+		}
+		// Ignores offset and limit and always answers with everything.
+		w.Write([]byte(`{"results":[{"id":"1"},{"id":"2"},{"id":"3"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 2
+
+	items, err := listAll[*PlatformArtifact](context.Background(), client, ARTIFACT_PATH+"?nrn=organization=1:account=2", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || atomic.LoadInt32(&requests) != 1 {
+		t.Errorf("got %d items in %d requests, want 3 in 1", len(items), requests)
+	}
+}
+
+func TestPaginate_StopsWhenTheCallerDoes(t *testing.T) {
+	server, requests := newPagedServer(t, 10, func(item map[string]interface{}, i int) {
+		item["id"] = i
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 2
+
+	seen := 0
+	for _, err := range paginate[*Parameter](context.Background(), client, PARAMETER_PATH, "parameters") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen++
+		if seen == 3 {
+			break
+		}
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("sent %d requests, want only the 2 pages that were consumed", got)
+	}
+}
+
+func TestPaginate_YieldsErrors(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "0" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"results":[{"id":1},{"id":2}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 2
+
+	_, err := listAll[*Parameter](context.Background(), client, PARAMETER_PATH, "parameters")
+	var forbidden *ForbiddenError
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("listAll() error = %v, want the second page's 403", err)
+	}
+}
+
+func TestGetLatestSnapshotID_ReadsEveryPage(t *testing.T) {
+	server, _ := newPagedServer(t, 5, func(item map[string]interface{}, i int) {
+		// The newest snapshot is on the last page.
+		item["sequence_number"] = i
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 2
+
+	id, err := client.GetLatestSnapshotID(context.Background(), "service_specification", "spec-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "4" {
+		t.Errorf("GetLatestSnapshotID() = %q, want the snapshot with the highest sequence_number", id)
+	}
+}
+
+func TestCreateParameter_FindsExistingParameterOnALaterPage(t *testing.T) {
+	server, _ := newPagedServer(t, 5, func(item map[string]interface{}, i int) {
+		item["id"] = i
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.PageSize = 2
+
+	param, err := client.CreateParameter(context.Background(), &Parameter{Name: "item-4", Nrn: "organization=1"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if param.Id != 4 {
+		t.Errorf("CreateParameter() = %+v, want the existing parameter imported", param)
+	}
+}
+
+func TestWithPaging(t *testing.T) {
+	got, err := withPaging("/parameter/?nrn=organization=1&limit=200&hide_values=true", 40, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/parameter/?hide_values=true&limit=20&nrn=organization%3D1&offset=40"; got != want {
+		t.Errorf("withPaging() = %s, want %s", got, want)
+	}
+}
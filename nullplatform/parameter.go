@@ -2,11 +2,11 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strconv"
 	"time"
 )
@@ -42,8 +42,8 @@ type ParameterList struct {
 	Results []*Parameter `json:"results,omitempty"`
 }
 
-func (c *NullClient) CreateParameter(param *Parameter, importIfCreated bool) (*Parameter, error) {
-	parameterList, err := c.GetParameterList(param.Nrn, true)
+func (c *NullClient) CreateParameter(ctx context.Context, param *Parameter, importIfCreated bool) (*Parameter, error) {
+	parameterList, err := c.GetParameterList(ctx, param.Nrn, true)
 	if err != nil {
 		return nil, err
 	}
@@ -59,23 +59,14 @@ func (c *NullClient) CreateParameter(param *Parameter, importIfCreated bool) (*P
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", PARAMETER_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", PARAMETER_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusGatewayTimeout {
-			return nil, fmt.Errorf("error creating Parameter, status code: %d, message: Gateway Timeout", res.StatusCode)
-		}
-
-		nErr := &NullErrors{}
-		dErr := json.NewDecoder(res.Body).Decode(nErr)
-		if dErr != nil {
-			return nil, fmt.Errorf("error creating Parameter, status code: %d, message: %s", res.StatusCode, dErr)
-		}
-		return nil, fmt.Errorf("error creating Parameter, status code: %d, message: %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "parameter", "create"); err != nil {
+		return nil, err
 	}
 
 	paramRes = &Parameter{}
@@ -88,21 +79,21 @@ func (c *NullClient) CreateParameter(param *Parameter, importIfCreated bool) (*P
 	return paramRes, nil
 }
 
-func (c *NullClient) GetParameter(parameterId string, nrn *string) (*Parameter, error) {
+func (c *NullClient) GetParameter(ctx context.Context, parameterId string, nrn *string) (*Parameter, error) {
 	path := fmt.Sprintf("%s/%s", PARAMETER_PATH, parameterId)
 
 	if nrn != nil && *nrn != "" {
 		path = fmt.Sprintf("%s?nrn=%s", path, *nrn)
 	}
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, &HTTPStatusError{Status: res.StatusCode, Message: fmt.Sprintf("error getting Parameter resource for %s", parameterId)}
+	if err := checkResponse(res, "parameter", "get"); err != nil {
+		return nil, err
 	}
 
 	param := &Parameter{}
@@ -115,7 +106,7 @@ func (c *NullClient) GetParameter(parameterId string, nrn *string) (*Parameter,
 	return param, nil
 }
 
-func (c *NullClient) PatchParameter(parameterId string, param *Parameter) error {
+func (c *NullClient) PatchParameter(ctx context.Context, parameterId string, param *Parameter) error {
 	path := fmt.Sprintf("%s/%s", PARAMETER_PATH, parameterId)
 
 	var buf bytes.Buffer
@@ -124,36 +115,28 @@ func (c *NullClient) PatchParameter(parameterId string, param *Parameter) error
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error patching Parameter resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "parameter", "update")
 }
 
-func (c *NullClient) DeleteParameter(parameterId string) error {
+func (c *NullClient) DeleteParameter(ctx context.Context, parameterId string) error {
 	path := fmt.Sprintf("%s/%s", PARAMETER_PATH, parameterId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting Parameter resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "parameter", "delete")
 }
 
-func (c *NullClient) CreateParameterValue(paramId int, paramValue *ParameterValue) (*ParameterValue, error) {
+func (c *NullClient) CreateParameterValue(ctx context.Context, paramId int, paramValue *ParameterValue) (*ParameterValue, error) {
 	path := fmt.Sprintf("%s/%s/value", PARAMETER_PATH, strconv.Itoa(paramId))
 
 	var buf bytes.Buffer
@@ -162,22 +145,14 @@ func (c *NullClient) CreateParameterValue(paramId int, paramValue *ParameterValu
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", path, &buf)
+	res, err := c.MakeRequest(ctx, "POST", path, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusGatewayTimeout {
-			return nil, &HTTPStatusError{Status: res.StatusCode, Message: "error creating Parameter Value: Gateway Timeout"}
-		}
-
-		nErr := &NullErrors{}
-		if dErr := json.NewDecoder(res.Body).Decode(nErr); dErr != nil {
-			return nil, &HTTPStatusError{Status: res.StatusCode, Message: fmt.Sprintf("error creating Parameter Value: %s", dErr)}
-		}
-		return nil, &HTTPStatusError{Status: res.StatusCode, Message: fmt.Sprintf("error creating Parameter Value: %s", nErr.Message)}
+	if err := checkResponse(res, "parameter value", "create"); err != nil {
+		return nil, err
 	}
 
 	paramRes := &ParameterValue{}
@@ -190,26 +165,22 @@ func (c *NullClient) CreateParameterValue(paramId int, paramValue *ParameterValu
 	return paramRes, nil
 }
 
-func (c *NullClient) DeleteParameterValue(parameterId string, parameterValueId string) error {
+func (c *NullClient) DeleteParameterValue(ctx context.Context, parameterId string, parameterValueId string) error {
 	path := fmt.Sprintf("%s/%s/value/%s", PARAMETER_PATH, parameterId, parameterValueId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return &HTTPStatusError{Status: res.StatusCode, Message: "error deleting Parameter Value"}
-	}
-
-	return nil
+	return checkResponse(res, "parameter value", "delete")
 }
 
-func (c *NullClient) GetParameterValue(parameterId string, parameterValueId string, nrn *string) (*ParameterValue, error) {
+func (c *NullClient) GetParameterValue(ctx context.Context, parameterId string, parameterValueId string, nrn *string) (*ParameterValue, error) {
 	var parameterValue *ParameterValue
 
-	param, err := c.GetParameter(parameterId, nrn)
+	param, err := c.GetParameter(ctx, parameterId, nrn)
 	if err != nil {
 		return nil, fmt.Errorf("Parameter ID %s not found: %w", parameterId, err)
 	}
@@ -223,7 +194,7 @@ func (c *NullClient) GetParameterValue(parameterId string, parameterValueId stri
 	}
 
 	if parameterValue == nil {
-		return nil, fmt.Errorf("parameter Value ID %s not found", parameterValueId)
+		return nil, &ResourceNotFoundError{ApiType: "parameter value", Message: fmt.Sprintf("parameter Value ID %s not found", parameterValueId)}
 	}
 
 	return parameterValue, nil
@@ -251,9 +222,7 @@ func generateParameterValueID(value *ParameterValue, parameterId int) string {
 	return hashString
 }
 
-func (c *NullClient) GetParameterList(nrn string, hideValues ...bool) (*ParameterList, error) {
-	// TODO: Implement pagination
-
+func (c *NullClient) GetParameterList(ctx context.Context, nrn string, hideValues ...bool) (*ParameterList, error) {
 	/*
 		This query string parameter is used to avoid decrypting parameter values in the response.
 		It should be light-weight than asking for decryption.
@@ -263,26 +232,14 @@ func (c *NullClient) GetParameterList(nrn string, hideValues ...bool) (*Paramete
 		hide = "true"
 	}
 
-	path := fmt.Sprintf("%s/?nrn=%s&limit=200&hide_values=%s", PARAMETER_PATH, nrn, hide)
+	path := fmt.Sprintf("%s/?nrn=%s&hide_values=%s", PARAMETER_PATH, nrn, hide)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	params, err := listAll[*Parameter](ctx, c, path, "parameters")
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
 
-	param := &ParameterList{}
-	derr := json.NewDecoder(res.Body).Decode(param)
-
-	if derr != nil {
-		return nil, derr
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting Parameter List, got %d for %s", res.StatusCode, nrn)
-	}
-
-	return param, nil
+	return &ParameterList{Results: params}, nil
 }
 
 func parameterExists(parameterList *ParameterList, param *Parameter) (*Parameter, bool) {
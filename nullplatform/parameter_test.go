@@ -106,17 +106,17 @@ func TestIsRetryableError(t *testing.T) {
 	}{
 		{"nil error", nil, false},
 		{"plain error", errors.New("boom"), false},
-		{"408 timeout", &HTTPStatusError{Status: http.StatusRequestTimeout, Message: "x"}, true},
-		{"409 conflict", &HTTPStatusError{Status: http.StatusConflict, Message: "x"}, true},
-		{"429 too many", &HTTPStatusError{Status: http.StatusTooManyRequests, Message: "x"}, true},
-		{"502 bad gateway", &HTTPStatusError{Status: http.StatusBadGateway, Message: "x"}, true},
-		{"503 unavailable", &HTTPStatusError{Status: http.StatusServiceUnavailable, Message: "x"}, true},
-		{"504 gateway timeout", &HTTPStatusError{Status: http.StatusGatewayTimeout, Message: "x"}, true},
-		{"400 already exists", &HTTPStatusError{Status: http.StatusBadRequest, Message: "The parameter already exists"}, true},
-		{"400 other", &HTTPStatusError{Status: http.StatusBadRequest, Message: "invalid input"}, false},
-		{"404 not found", &HTTPStatusError{Status: http.StatusNotFound, Message: "x"}, false},
-		{"500 internal", &HTTPStatusError{Status: http.StatusInternalServerError, Message: "x"}, false},
-		{"wrapped 503", fmt.Errorf("context: %w", &HTTPStatusError{Status: http.StatusServiceUnavailable, Message: "x"}), true},
+		{"408 timeout", &APIError{Status: http.StatusRequestTimeout, Message: "x"}, true},
+		{"409 conflict", &ConflictError{&APIError{Status: http.StatusConflict, Message: "x"}}, true},
+		{"429 too many", &APIError{Status: http.StatusTooManyRequests, Message: "x"}, true},
+		{"502 bad gateway", &APIError{Status: http.StatusBadGateway, Message: "x"}, true},
+		{"503 unavailable", &APIError{Status: http.StatusServiceUnavailable, Message: "x"}, true},
+		{"504 gateway timeout", &APIError{Status: http.StatusGatewayTimeout, Message: "x"}, true},
+		{"400 already exists", &ValidationError{&APIError{Status: http.StatusBadRequest, Message: "The parameter already exists"}}, true},
+		{"400 other", &APIError{Status: http.StatusBadRequest, Message: "invalid input"}, false},
+		{"404 not found", &ResourceNotFoundError{ApiType: "parameter", API: &APIError{Status: http.StatusNotFound, Message: "x"}}, false},
+		{"500 internal", &APIError{Status: http.StatusInternalServerError, Message: "x"}, false},
+		{"wrapped 503", fmt.Errorf("context: %w", &APIError{Status: http.StatusServiceUnavailable, Message: "x"}), true},
 	}
 
 	for _, tt := range tests {
@@ -2,10 +2,10 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 )
 
 const (
@@ -48,40 +48,28 @@ type PlatformArtifact struct {
 	CreatedAt        string                 `json:"created_at,omitempty"`
 }
 
-type platformArtifactListResponse struct {
-	Results []*PlatformArtifact `json:"results"`
-}
-
-type platformArtifactRevisionListResponse struct {
-	Results []*PlatformArtifactRevision `json:"results"`
-}
-
-func (c *NullClient) RegisterPlatformArtifact(r *PlatformArtifactRegistration) (*PlatformArtifactRevision, error) {
+func (c *NullClient) RegisterPlatformArtifact(ctx context.Context, r *PlatformArtifactRegistration) (*PlatformArtifactRevision, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(r); err != nil {
 		return nil, fmt.Errorf("error encoding artifact registration: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", ARTIFACT_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", ARTIFACT_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error making POST request: %v", err)
 	}
 	defer res.Body.Close()
 
+	// 201 on first registration, 200 when the (artifact, revision) pair is reused.
+	if err := checkResponse(res, "platform artifact", "register"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	// 201 on first registration, 200 when the (artifact, revision) pair is reused.
-	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
-			return nil, fmt.Errorf("API error registering artifact: %s (Code: %s)", errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error registering artifact, got status code: %d, body: %s", res.StatusCode, string(body))
-	}
-
 	revision := &PlatformArtifactRevision{}
 	if err := json.Unmarshal(body, revision); err != nil {
 		return nil, fmt.Errorf("error decoding artifact registration response: %v", err)
@@ -90,10 +78,10 @@ func (c *NullClient) RegisterPlatformArtifact(r *PlatformArtifactRegistration) (
 	return revision, nil
 }
 
-func (c *NullClient) GetPlatformArtifact(artifactID string) (*PlatformArtifact, error) {
+func (c *NullClient) GetPlatformArtifact(ctx context.Context, artifactID string) (*PlatformArtifact, error) {
 	path := fmt.Sprintf("%s/%s", ARTIFACT_PATH, artifactID)
 
-	body, err := c.getJSON(path, "artifact")
+	body, err := c.getJSON(ctx, path, "artifact")
 	if err != nil {
 		return nil, err
 	}
@@ -109,10 +97,10 @@ func (c *NullClient) GetPlatformArtifact(artifactID string) (*PlatformArtifact,
 // GetPlatformArtifactRevision resolves a revision directly by id via the
 // standalone GET /artifact_revision/:id endpoint — no parent artifact id
 // needed.
-func (c *NullClient) GetPlatformArtifactRevision(revisionID string) (*PlatformArtifactRevision, error) {
+func (c *NullClient) GetPlatformArtifactRevision(ctx context.Context, revisionID string) (*PlatformArtifactRevision, error) {
 	path := fmt.Sprintf("%s/%s", ARTIFACT_REVISION_PATH, revisionID)
 
-	body, err := c.getJSON(path, "artifact revision")
+	body, err := c.getJSON(ctx, path, "artifact revision")
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +113,7 @@ func (c *NullClient) GetPlatformArtifactRevision(revisionID string) (*PlatformAr
 	return revision, nil
 }
 
-func (c *NullClient) ListPlatformArtifacts(nrn, artifactType string) ([]*PlatformArtifact, error) {
+func (c *NullClient) ListPlatformArtifacts(ctx context.Context, nrn, artifactType string) ([]*PlatformArtifact, error) {
 	params := map[string]string{}
 	if nrn != "" {
 		params["nrn"] = nrn
@@ -136,56 +124,32 @@ func (c *NullClient) ListPlatformArtifacts(nrn, artifactType string) ([]*Platfor
 
 	path := fmt.Sprintf("%s%s", ARTIFACT_PATH, c.PrepareQueryString(params))
 
-	body, err := c.getJSON(path, "artifacts")
-	if err != nil {
-		return nil, err
-	}
-
-	response := &platformArtifactListResponse{}
-	if err := json.Unmarshal(body, response); err != nil {
-		return nil, fmt.Errorf("error decoding artifact list: %v", err)
-	}
-
-	return response.Results, nil
+	return listAll[*PlatformArtifact](ctx, c, path, "artifacts")
 }
 
-func (c *NullClient) ListPlatformArtifactRevisions(artifactID string) ([]*PlatformArtifactRevision, error) {
+func (c *NullClient) ListPlatformArtifactRevisions(ctx context.Context, artifactID string) ([]*PlatformArtifactRevision, error) {
 	path := fmt.Sprintf("%s/%s/revisions", ARTIFACT_PATH, artifactID)
 
-	body, err := c.getJSON(path, "artifact revisions")
-	if err != nil {
-		return nil, err
-	}
-
-	response := &platformArtifactRevisionListResponse{}
-	if err := json.Unmarshal(body, response); err != nil {
-		return nil, fmt.Errorf("error decoding artifact revision list: %v", err)
-	}
-
-	return response.Results, nil
+	return listAll[*PlatformArtifactRevision](ctx, c, path, "artifact revisions")
 }
 
 // getJSON performs a GET and returns the raw body on 200, mapping API error
 // envelopes into readable errors otherwise.
-func (c *NullClient) getJSON(path, entity string) ([]byte, error) {
-	res, err := c.MakeRequest("GET", path, nil)
+func (c *NullClient) getJSON(ctx context.Context, path, entity string) ([]byte, error) {
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error making GET request: %v", err)
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, entity, "get"); err != nil {
+		return nil, err
+	}
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading response body: %v", err)
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errResp ErrorResponse
-		if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
-			return nil, fmt.Errorf("API error getting %s: %s (Code: %s)", entity, errResp.Message, errResp.Code)
-		}
-		return nil, fmt.Errorf("error getting %s, got status code: %d, body: %s", entity, res.StatusCode, string(body))
-	}
-
 	return body, nil
 }
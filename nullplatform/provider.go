@@ -2,18 +2,40 @@ package nullplatform
 
 import (
 	"context"
-	"log"
-	"net/http"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const API_KEY = "api_key"
+const ACCESS_TOKEN = "access_token"
+const TOKEN_FILE = "token_file"
+const EXEC = "exec"
+const PROFILE = "profile"
+const ORGANIZATION_ID = "organization_id"
+const DEFAULT_DIMENSIONS = "default_dimensions"
+const VALIDATE_DIMENSIONS = "validate_dimensions"
 const HOST = "host"
 const NP_API_KEY = "np_apikey"
 const NP_API_HOST = "np_api_host"
+const RETRY = "retry"
+const MAX_REQUESTS_PER_SECOND = "max_requests_per_second"
+const MAX_CONCURRENT_REQUESTS = "max_concurrent_requests"
+const BASE_URL = "base_url"
+const REQUEST_TIMEOUT = "request_timeout"
+const TLS_HANDSHAKE_TIMEOUT = "tls_handshake_timeout"
+const HTTPS_PROXY = "https_proxy"
+const CA_BUNDLE_FILES = "ca_bundle_files"
+const INSECURE_SKIP_VERIFY = "insecure_skip_verify"
+const PAGE_SIZE = "page_size"
+const READ_CACHE = "read_cache"
+const READ_CACHE_TTL = "read_cache_ttl"
 
 const DEFAULT_HOST = "api.nullplatform.com"
 
@@ -34,6 +56,53 @@ func Provider() *schema.Provider {
 				Sensitive:   true,
 				Description: "Nullplatform API KEY. Can also be set with the `NULLPLATFORM_API_KEY` environment variable.",
 			},
+			ACCESS_TOKEN: {
+				Type:        schema.TypeString,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_ACCESS_TOKEN", nil),
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Pre-issued nullplatform access token, used as is instead of exchanging an API key. It is not refreshed, so it must outlive the run. Can also be set with the `NULLPLATFORM_ACCESS_TOKEN` environment variable.",
+			},
+			TOKEN_FILE: {
+				Type:        schema.TypeString,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_TOKEN_FILE", nil),
+				Optional:    true,
+				Description: "Path to a file holding a nullplatform access token, used instead of exchanging an API key. The file is read again whenever it changes, so it can be rotated during the run. Can also be set with the `NULLPLATFORM_TOKEN_FILE` environment variable.",
+			},
+			EXEC: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Credential helper command that prints a nullplatform access token, used instead of exchanging an API key. The command must print a JSON object such as `{\"token\": \"...\", \"expiry\": \"2030-01-01T00:00:00Z\"}` on stdout; `expiry` is optional and defaults to the token's `exp` claim. It is run again shortly before the token expires.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Command to run, looked up in `PATH` when it is not a path.",
+						},
+						"args": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Arguments passed to the command.",
+						},
+					},
+				},
+			},
+			PROFILE: {
+				Type:        schema.TypeString,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_PROFILE", ""),
+				Optional:    true,
+				Description: "Name of the profile to read the host, credentials and default NRN from, in the config file at `~/.nullplatform/config` (or `NULLPLATFORM_CONFIG_FILE`). Attributes set in the provider configuration take precedence over the profile, and a selected profile takes precedence over the `NULLPLATFORM_*` environment variables, which only override the `default` profile. When omitted, the `default` profile is used if the file defines one. Can also be set with the `NULLPLATFORM_PROFILE` environment variable.",
+			},
+			ORGANIZATION_ID: {
+				Type:         schema.TypeString,
+				DefaultFunc:  schema.EnvDefaultFunc("NULLPLATFORM_ORGANIZATION_ID", nil),
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric organization ID"),
+				Description:  "ID of the organization every NRN is built under. When omitted, it is taken from the profile's `nrn`, then from the access token when it names exactly one organization; otherwise the resources and data sources that build NRNs fail. Can also be set with the `NULLPLATFORM_ORGANIZATION_ID` environment variable.",
+			},
 			HOST: {
 				Type:        schema.TypeString,
 				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_HOST", nil),
@@ -53,6 +122,131 @@ func Provider() *schema.Provider {
 				Description: "Nullplatform API HOSTNAME. Can also be set with the `NP_API_HOST` environment variable. If omitted, the default value is `api.nullplatform.com`",
 				Deprecated:  "The 'np_api_host' attribute is deprecated and will be removed in a future version. Please use 'host' instead.",
 			},
+			BASE_URL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_BASE_URL", nil),
+				Description: "Full base URL of the nullplatform API, including the scheme and an optional path prefix (e.g. `http://localhost:8080/api`). Takes precedence over `host`. Can also be set with the `NULLPLATFORM_BASE_URL` environment variable.",
+			},
+			REQUEST_TIMEOUT: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultRequestTimeout.String(),
+				ValidateFunc: validateDuration,
+				Description:  "Maximum time a single API request may take, including reading the response. Defaults to `2m0s`.",
+			},
+			TLS_HANDSHAKE_TIMEOUT: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultTLSHandshakeTimeout.String(),
+				ValidateFunc: validateDuration,
+				Description:  "Maximum time to wait for the TLS handshake with the API. Defaults to `10s`.",
+			},
+			HTTPS_PROXY: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the proxy used for every API request (e.g. `http://proxy.internal:3128`). If omitted, the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables are honored.",
+			},
+			CA_BUNDLE_FILES: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Paths to PEM encoded CA bundles trusted in addition to the system roots, for endpoints using an internal certificate authority.",
+			},
+			INSECURE_SKIP_VERIFY: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the verification of the API TLS certificate. Only intended for local or test endpoints. Defaults to `false`.",
+			},
+			MAX_REQUESTS_PER_SECOND: {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NULLPLATFORM_MAX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Maximum number of API requests per second the provider sends, shared by all resources. Can also be set with the `NULLPLATFORM_MAX_REQUESTS_PER_SECOND` environment variable. Defaults to `0` (unlimited).",
+			},
+			MAX_CONCURRENT_REQUESTS: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NULLPLATFORM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of API requests in flight at the same time, shared by all resources. Can also be set with the `NULLPLATFORM_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `0` (unlimited).",
+			},
+			DEFAULT_DIMENSIONS: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Dimensions merged into the `dimensions` of every resource that has them (approval actions, entity hook actions, links, parameter values, provider configs, runtime configurations, scopes and services). Keys set on the resource take precedence. The merged result is exposed as the resource's `dimensions_all`. Most of these resources cannot change their dimensions in place, so changing a default replaces them.",
+			},
+			VALIDATE_DIMENSIONS: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_VALIDATE_DIMENSIONS", true),
+				Description: "Check the dimensions of every resource against the ones defined at its NRN at plan time, so a likely typo of a known key or value fails the plan instead of the apply. Keys and values unlike any known one pass, since the same apply may create them. Can also be set with the `NULLPLATFORM_VALIDATE_DIMENSIONS` environment variable. Defaults to `true`.",
+			},
+			PAGE_SIZE: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultPageSize,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Number of results requested per page from list endpoints. Every page is read, so this only trades the number of requests against their size. Defaults to `100`.",
+			},
+			READ_CACHE: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NULLPLATFORM_READ_CACHE", true),
+				Description: "Cache the lookups resources repeat during a run (account, namespace, application and scope slugs, action specification lists and specification snapshots) for `read_cache_ttl`. Writes made by the provider invalidate the entries they affect, but changes made outside Terraform during the run are not seen. Can also be set with the `NULLPLATFORM_READ_CACHE` environment variable. Defaults to `true`.",
+			},
+			READ_CACHE_TTL: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      defaultReadCacheTTL.String(),
+				ValidateFunc: validateDuration,
+				Description:  "How long a cached lookup is served when `read_cache` is enabled. Defaults to `5m0s`.",
+			},
+			RETRY: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy for API calls. GET, PUT and DELETE requests are retried on any of `retryable_status_codes`; POST and PATCH requests are only retried when the connection failed before the request was sent or the API answered `429` or `503`. A `Retry-After` header on the response takes precedence over the computed backoff.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      defaultRetryMaxAttempts,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of attempts per request, including the first one. Set to `1` to disable retries. Defaults to `4`.",
+						},
+						"min_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultRetryMinBackoff.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Wait before the first retry, doubled on every following one (with jitter). Defaults to `250ms`.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      defaultRetryMaxBackoff.String(),
+							ValidateFunc: validateDuration,
+							Description:  "Upper bound for the wait between retries, also applied to `Retry-After`. Defaults to `30s`.",
+						},
+						"retryable_status_codes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+							Description: "HTTP status codes that trigger a retry. Defaults to `[408, 409, 429, 502, 503, 504]`.",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"nullplatform_account":                            resourceAccount(),
@@ -89,6 +283,7 @@ func Provider() *schema.Provider {
 			"nullplatform_provider_specification":             resourceProviderSpecification(),
 			"nullplatform_artifact":                           resourcePlatformArtifact(),
 			"nullplatform_package":                            resourcePackage(),
+			"nullplatform_nrn_namespace":                      resourceNRNNamespace(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"nullplatform_dimension":             dataSourceDimension(),
@@ -103,27 +298,79 @@ func Provider() *schema.Provider {
 			"nullplatform_action_specifications": dataSourceActionSpecifications(),
 			"nullplatform_artifact":              dataSourcePlatformArtifact(),
 			"nullplatform_package":               dataSourcePackage(),
+			"nullplatform_nrn":                   dataSourceNRN(),
+			"nullplatform_nrn_namespace":         dataSourceNRNNamespace(),
 		},
 	}
 
-	provider.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
-		apiKey, apiKeyDiags := getAPIKey(d)
-		apiUrl, apiUrlDiags := getAPIHost(d)
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		profile, diags := loadProfile(d)
+		if hasErrors(diags) {
+			return nil, diags
+		}
+
+		tokenSource, tokenSourceDiags := getTokenSource(d, profile)
+		diags = append(diags, tokenSourceDiags...)
+		var apiKey string
+		if tokenSource == nil && !hasErrors(diags) {
+			var apiKeyDiags diag.Diagnostics
+			apiKey, apiKeyDiags = getAPIKey(d, profile)
+			diags = append(diags, apiKeyDiags...)
+		}
+		apiUrl, apiUrlDiags := getAPIHost(d, profile)
+		diags = append(diags, apiUrlDiags...)
 
-		diags := append(apiKeyDiags, apiUrlDiags...)
+		organizationID, organizationIDDiags := getOrganizationID(d, profile)
+		diags = append(diags, organizationIDDiags...)
 		if len(diags) > 0 && hasErrors(diags) {
 			return nil, diags
 		}
+		diags = append(diags, settingSourcesOverridden(d, profile)...)
+
+		baseURL, baseURLDiags := getBaseURL(d, apiUrl)
+		diags = append(diags, baseURLDiags...)
+		if hasErrors(diags) {
+			return nil, diags
+		}
+
+		httpClient, httpClientDiags := newHTTPClient(ctx, d)
+		diags = append(diags, httpClientDiags...)
+		if hasErrors(diags) {
+			return nil, diags
+		}
+
+		retryPolicy, err := getRetryPolicy(d)
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+
+		var cache *ReadCache
+		if d.Get(READ_CACHE).(bool) {
+			ttl, err := time.ParseDuration(d.Get(READ_CACHE_TTL).(string))
+			if err != nil {
+				return nil, append(diags, diag.FromErr(err)...)
+			}
+			if ttl > 0 {
+				cache = NewReadCache(ttl)
+			}
+		}
 
 		c := &NullClient{
-			Client: &http.Client{
-				Transport: &LoggingTransport{
-					Transport: http.DefaultTransport,
-					Logger:    log.New(os.Stdout, "HTTP: \n\n", log.Ldate|log.Ltime),
-				},
-			},
-			ApiKey: apiKey,
-			ApiURL: apiUrl,
+			Client:                  httpClient,
+			ApiKey:                  apiKey,
+			TokenSource:             tokenSource,
+			ApiURL:                  apiUrl,
+			BaseURL:                 baseURL,
+			RetryPolicy:             retryPolicy,
+			PageSize:                d.Get(PAGE_SIZE).(int),
+			Cache:                   cache,
+			OrganizationID:          organizationID,
+			DefaultDimensions:       mapOfInterfacesToMapOfStrings(d.Get(DEFAULT_DIMENSIONS).(map[string]interface{})),
+			SkipDimensionValidation: !d.Get(VALIDATE_DIMENSIONS).(bool),
+			Limiter: NewRequestLimiter(
+				d.Get(MAX_REQUESTS_PER_SECOND).(float64),
+				d.Get(MAX_CONCURRENT_REQUESTS).(int),
+			),
 		}
 
 		return c, diags
@@ -132,10 +379,16 @@ func Provider() *schema.Provider {
 	return provider
 }
 
-func getAPIKey(d *schema.ResourceData) (string, diag.Diagnostics) {
+// getAPIKey resolves the API key from, in order: the api_key attribute or
+// NULLPLATFORM_API_KEY, the profile, and the deprecated np_apikey attribute or
+// NP_API_KEY. A selected profile takes precedence over NULLPLATFORM_API_KEY.
+func getAPIKey(d *schema.ResourceData, profile *Profile) (string, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	if v, ok := d.GetOk(API_KEY); ok {
-		return v.(string), diags
+	if v, ok := configuredSetting(d, profile, API_KEY, "NULLPLATFORM_API_KEY"); ok {
+		return v, diags
+	}
+	if profile != nil && profile.APIKey != "" {
+		return profile.APIKey, append(diags, profileSettingUsed("API key", profile))
 	}
 	if v, ok := d.GetOk(NP_API_KEY); ok {
 		diags = append(diags, diag.Diagnostic{
@@ -153,18 +406,218 @@ func getAPIKey(d *schema.ResourceData) (string, diag.Diagnostics) {
 		})
 		return v, diags
 	}
+	detail := "One of 'api_key', 'access_token', 'token_file' or 'exec' must be set, or a profile with credentials selected. Please provide credentials for authentication."
+	if profile.selected() {
+		detail = fmt.Sprintf("The selected %s has no credentials, and the NULLPLATFORM_* environment variables are ignored while a profile is selected. Add credentials to the profile or set 'api_key', 'access_token', 'token_file' or 'exec' in the provider configuration.", profile.source())
+	}
 	diags = append(diags, diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  "Missing API Key",
-		Detail:   "Either 'api_key' or 'np_apikey' must be set. Please provide an API key for authentication.",
+		Detail:   detail,
 	})
 	return "", diags
 }
 
-func getAPIHost(d *schema.ResourceData) (string, diag.Diagnostics) {
+// profileSettingUsed reports that setting was read from profile, so the
+// source of a value is visible without debug logs.
+func profileSettingUsed(setting string, profile *Profile) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Setting Read From Profile",
+		Detail:   fmt.Sprintf("Using the %s from %s.", setting, profile.source()),
+	}
+}
+
+// sourcesOverridden warns that setting is set by more than one of sources,
+// listed in order of precedence, and names the one used, so a value that
+// seems to have no effect is explained.
+func sourcesOverridden(setting string, sources []string) diag.Diagnostics {
+	if len(sources) < 2 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Multiple Sources for " + setting,
+		Detail:   fmt.Sprintf("%s set by more than one source: using %s, ignoring %s.", setting, sources[0], strings.Join(sources[1:], ", ")),
+	}}
+}
+
+// setInConfig reports whether an attribute whose default is the environment
+// variable envVar is set in the configuration, rather than by the variable.
+func setInConfig(d *schema.ResourceData, attribute, envVar string) bool {
+	if raw := d.GetRawConfig(); !raw.IsNull() {
+		return !raw.GetAttr(attribute).IsNull()
+	}
+	// Without the raw configuration, only a value other than the variable's
+	// is known to come from the configuration.
+	v, ok := d.GetOk(attribute)
+	return ok && v.(string) != os.Getenv(envVar)
+}
+
+// configuredSetting returns an attribute whose default is the environment
+// variable envVar, and whether it is set. The variable is ignored when a
+// profile is selected: the profile then wins over the environment as a unit,
+// and only the configuration overrides it.
+func configuredSetting(d *schema.ResourceData, profile *Profile, attribute, envVar string) (string, bool) {
+	v, ok := d.GetOk(attribute)
+	if !ok || (profile.selected() && !setInConfig(d, attribute, envVar)) {
+		return "", false
+	}
+	return v.(string), true
+}
+
+// configuredSources names where an attribute whose default is the
+// environment variable envVar is set, in order of precedence: the
+// configuration, then the variable unless a profile is selected.
+func configuredSources(d *schema.ResourceData, profile *Profile, attribute, envVar string) []string {
+	var sources []string
+	if setInConfig(d, attribute, envVar) {
+		sources = append(sources, fmt.Sprintf("'%s'", attribute))
+	}
+	if os.Getenv(envVar) != "" && !profile.selected() {
+		sources = append(sources, envVar)
+	}
+	return sources
+}
+
+// profileEnvVars are the environment variables of the settings a profile
+// holds, which a selected profile takes precedence over.
+var profileEnvVars = []string{
+	"NULLPLATFORM_ACCESS_TOKEN",
+	"NULLPLATFORM_TOKEN_FILE",
+	"NULLPLATFORM_API_KEY",
+	"NULLPLATFORM_HOST",
+	"NULLPLATFORM_ORGANIZATION_ID",
+}
+
+// environmentIgnored warns about the profileEnvVars that are set while a
+// profile is selected, which the profile takes precedence over.
+func environmentIgnored(profile *Profile) diag.Diagnostics {
+	if !profile.selected() {
+		return nil
+	}
+	var ignored []string
+	for _, envVar := range profileEnvVars {
+		if os.Getenv(envVar) != "" {
+			ignored = append(ignored, envVar)
+		}
+	}
+	if len(ignored) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Environment Variables Ignored",
+		Detail: fmt.Sprintf("The selected %s takes precedence over %s. Set the matching attributes in the provider configuration to override the profile.",
+			profile.source(), strings.Join(ignored, ", ")),
+	}}
+}
+
+// settingSourcesOverridden warns about every setting of the provider that is
+// set by more than one source: the credentials, host, organization and
+// profile.
+func settingSourcesOverridden(d *schema.ResourceData, profile *Profile) diag.Diagnostics {
+	// Credentials, in the order getTokenSource and getAPIKey pick them.
+	credentials := configuredSources(d, profile, ACCESS_TOKEN, "NULLPLATFORM_ACCESS_TOKEN")
+	credentials = append(credentials, configuredSources(d, profile, TOKEN_FILE, "NULLPLATFORM_TOKEN_FILE")...)
+	if blocks := d.Get(EXEC).([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		credentials = append(credentials, fmt.Sprintf("'%s'", EXEC))
+	}
+	credentials = append(credentials, configuredSources(d, profile, API_KEY, "NULLPLATFORM_API_KEY")...)
+	if profile.hasCredentials() {
+		credentials = append(credentials, profile.source())
+	}
+	if _, ok := d.GetOk(NP_API_KEY); ok {
+		credentials = append(credentials, fmt.Sprintf("'%s'", NP_API_KEY))
+	}
+	if os.Getenv(NP_API_KEY_ENV) != "" {
+		credentials = append(credentials, NP_API_KEY_ENV)
+	}
+
+	hosts := configuredSources(d, profile, HOST, "NULLPLATFORM_HOST")
+	if profile != nil && profile.Host != "" {
+		hosts = append(hosts, profile.source())
+	}
+	if _, ok := d.GetOk(NP_API_HOST); ok {
+		hosts = append(hosts, fmt.Sprintf("'%s'", NP_API_HOST))
+	}
+	if os.Getenv(NP_API_HOST_ENV) != "" {
+		hosts = append(hosts, NP_API_HOST_ENV)
+	}
+
+	organizations := configuredSources(d, profile, ORGANIZATION_ID, "NULLPLATFORM_ORGANIZATION_ID")
+	if profile.organizationID() != "" {
+		organizations = append(organizations, profile.source())
+	}
+
 	var diags diag.Diagnostics
-	if v, ok := d.GetOk(HOST); ok {
-		return v.(string), diags
+	diags = append(diags, sourcesOverridden("Credentials", credentials)...)
+	diags = append(diags, sourcesOverridden("Host", hosts)...)
+	diags = append(diags, sourcesOverridden("Organization", organizations)...)
+	diags = append(diags, sourcesOverridden("Profile", configuredSources(d, nil, PROFILE, "NULLPLATFORM_PROFILE"))...)
+	diags = append(diags, environmentIgnored(profile)...)
+	return diags
+}
+
+// getTokenSource returns the token source for access_token, token_file or
+// exec, falling back to the profile's access_token or token_file when no
+// credential is set on the provider. It returns nil when the API key is
+// exchanged instead.
+func getTokenSource(d *schema.ResourceData, profile *Profile) (TokenSource, diag.Diagnostics) {
+	var sources []TokenSource
+	var names []string
+
+	if v, ok := configuredSetting(d, profile, ACCESS_TOKEN, "NULLPLATFORM_ACCESS_TOKEN"); ok {
+		sources = append(sources, NewStaticTokenSource(v))
+		names = append(names, ACCESS_TOKEN)
+	}
+	if v, ok := configuredSetting(d, profile, TOKEN_FILE, "NULLPLATFORM_TOKEN_FILE"); ok {
+		sources = append(sources, NewFileTokenSource(v))
+		names = append(names, TOKEN_FILE)
+	}
+	if blocks := d.Get(EXEC).([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		block := blocks[0].(map[string]interface{})
+		var args []string
+		for _, arg := range block["args"].([]interface{}) {
+			args = append(args, arg.(string))
+		}
+		sources = append(sources, NewExecTokenSource(block["command"].(string), args))
+		names = append(names, EXEC)
+	}
+
+	if len(sources) > 1 {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Conflicting Authentication Methods",
+			Detail:   fmt.Sprintf("Only one of 'access_token', 'token_file' and 'exec' may be set, got %s. Check the NULLPLATFORM_ACCESS_TOKEN and NULLPLATFORM_TOKEN_FILE environment variables too.", strings.Join(names, ", ")),
+		}}
+	}
+	if len(sources) == 1 {
+		return sources[0], nil
+	}
+
+	if _, ok := configuredSetting(d, profile, API_KEY, "NULLPLATFORM_API_KEY"); ok || profile == nil {
+		return nil, nil
+	}
+	if profile.AccessToken != "" {
+		return NewStaticTokenSource(profile.AccessToken), diag.Diagnostics{profileSettingUsed("access token", profile)}
+	}
+	if profile.TokenFile != "" {
+		return NewFileTokenSource(profile.TokenFile), diag.Diagnostics{profileSettingUsed("token file", profile)}
+	}
+	return nil, nil
+}
+
+// getAPIHost resolves the host from, in order: the host attribute or
+// NULLPLATFORM_HOST, the profile, and the deprecated np_api_host attribute or
+// NP_API_HOST. A selected profile takes precedence over NULLPLATFORM_HOST.
+func getAPIHost(d *schema.ResourceData, profile *Profile) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v, ok := configuredSetting(d, profile, HOST, "NULLPLATFORM_HOST"); ok {
+		return v, diags
+	}
+	if profile != nil && profile.Host != "" {
+		return profile.Host, append(diags, profileSettingUsed("host "+profile.Host, profile))
 	}
 	if v, ok := d.GetOk(NP_API_HOST); ok {
 		diags = append(diags, diag.Diagnostic{
@@ -185,6 +638,59 @@ func getAPIHost(d *schema.ResourceData) (string, diag.Diagnostics) {
 	return DEFAULT_HOST, diags
 }
 
+// getOrganizationID returns the organization_id attribute (or
+// NULLPLATFORM_ORGANIZATION_ID, unless a profile is selected), falling back
+// to the organization of the profile's nrn. It returns "" when neither is
+// set, leaving the client to resolve it from the token.
+func getOrganizationID(d *schema.ResourceData, profile *Profile) (string, diag.Diagnostics) {
+	if v, ok := configuredSetting(d, profile, ORGANIZATION_ID, "NULLPLATFORM_ORGANIZATION_ID"); ok {
+		return v, nil
+	}
+	if orgID := profile.organizationID(); orgID != "" {
+		return orgID, diag.Diagnostics{profileSettingUsed("organization "+orgID, profile)}
+	}
+	return "", nil
+}
+
+func getRetryPolicy(d *schema.ResourceData) (*RetryPolicy, error) {
+	policy := DefaultRetryPolicy()
+
+	blocks := d.Get(RETRY).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return policy, nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	policy.MaxAttempts = block["max_attempts"].(int)
+
+	var err error
+	if policy.MinBackoff, err = time.ParseDuration(block["min_backoff"].(string)); err != nil {
+		return nil, fmt.Errorf("invalid retry.min_backoff: %w", err)
+	}
+	if policy.MaxBackoff, err = time.ParseDuration(block["max_backoff"].(string)); err != nil {
+		return nil, fmt.Errorf("invalid retry.max_backoff: %w", err)
+	}
+	if policy.MinBackoff > policy.MaxBackoff {
+		return nil, fmt.Errorf("retry.min_backoff (%s) must not be greater than retry.max_backoff (%s)", policy.MinBackoff, policy.MaxBackoff)
+	}
+
+	if codes := block["retryable_status_codes"].(*schema.Set).List(); len(codes) > 0 {
+		policy.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+		}
+	}
+
+	return policy, nil
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration such as \"500ms\" or \"2s\", got %q", k, v)}
+	}
+	return nil, nil
+}
+
 func hasErrors(diags diag.Diagnostics) bool {
 	for _, d := range diags {
 		if d.Severity == diag.Error {
@@ -2,10 +2,10 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 )
 
 const (
@@ -30,7 +30,7 @@ type SpecificationResponse struct {
 	Results []NpSpecification `json:"results"`
 }
 
-func (c *NullClient) CreateProviderConfig(p *ProviderConfig) (*ProviderConfig, error) {
+func (c *NullClient) CreateProviderConfig(ctx context.Context, p *ProviderConfig) (*ProviderConfig, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*p)
 
@@ -38,15 +38,14 @@ func (c *NullClient) CreateProviderConfig(p *ProviderConfig) (*ProviderConfig, e
 		return nil, fmt.Errorf("failed to encode provider config: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", PROVIDER_CONFIG_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", PROVIDER_CONFIG_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create provider config resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider config", "create"); err != nil {
+		return nil, err
 	}
 
 	pRes := &ProviderConfig{}
@@ -59,7 +58,7 @@ func (c *NullClient) CreateProviderConfig(p *ProviderConfig) (*ProviderConfig, e
 	return pRes, nil
 }
 
-func (c *NullClient) PatchProviderConfig(providerConfigId string, p *ProviderConfig) error {
+func (c *NullClient) PatchProviderConfig(ctx context.Context, providerConfigId string, p *ProviderConfig) error {
 	path := fmt.Sprintf("%s/%s", PROVIDER_CONFIG_PATH, providerConfigId)
 
 	var buf bytes.Buffer
@@ -69,32 +68,26 @@ func (c *NullClient) PatchProviderConfig(providerConfigId string, p *ProviderCon
 		return fmt.Errorf("failed to encode provider config: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch provider config resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "provider config", "update")
 }
 
-func (c *NullClient) GetProviderConfig(providerConfigId string) (*ProviderConfig, error) {
+func (c *NullClient) GetProviderConfig(ctx context.Context, providerConfigId string) (*ProviderConfig, error) {
 	path := fmt.Sprintf("%s/%s?no_merge=true", PROVIDER_CONFIG_PATH, providerConfigId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get provider config resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider config", "get"); err != nil {
+		return nil, err
 	}
 
 	p := &ProviderConfig{}
@@ -107,35 +100,35 @@ func (c *NullClient) GetProviderConfig(providerConfigId string) (*ProviderConfig
 	return p, nil
 }
 
-func (c *NullClient) DeleteProviderConfig(providerConfigId string) error {
+func (c *NullClient) DeleteProviderConfig(ctx context.Context, providerConfigId string) error {
 	path := fmt.Sprintf("%s/%s", PROVIDER_CONFIG_PATH, providerConfigId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) && (res.StatusCode != http.StatusNotFound) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete provider config resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider config", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (c *NullClient) GetSpecificationIdFromSlug(slug string, nrn string) (string, error) {
+func (c *NullClient) GetSpecificationIdFromSlug(ctx context.Context, slug string, nrn string) (string, error) {
 	path := fmt.Sprintf("%s?slug=%s&nrn=%s", SPECIFICATION_PATH, slug, nrn)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return "", fmt.Errorf("failed to get specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider specification", "find"); err != nil {
+		return "", err
 	}
 
 	var specResponse SpecificationResponse
@@ -151,18 +144,17 @@ func (c *NullClient) GetSpecificationIdFromSlug(slug string, nrn string) (string
 	return specResponse.Results[0].Id, nil
 }
 
-func (c *NullClient) GetSpecificationSlugFromId(id string) (string, error) {
+func (c *NullClient) GetSpecificationSlugFromId(ctx context.Context, id string) (string, error) {
 	path := fmt.Sprintf("%s/%s", SPECIFICATION_PATH, id)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return "", fmt.Errorf("failed to get specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider specification", "get"); err != nil {
+		return "", err
 	}
 
 	bodyBytes, err := io.ReadAll(res.Body)
@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 type NpCategory struct {
@@ -30,21 +29,20 @@ type ProviderSpecification struct {
 	OrganizationId    *int                   `json:"organization_id,omitempty"`
 }
 
-func (c *NullClient) CreateProviderSpecification(s *ProviderSpecification) (*ProviderSpecification, error) {
+func (c *NullClient) CreateProviderSpecification(ctx context.Context, s *ProviderSpecification) (*ProviderSpecification, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*s); err != nil {
 		return nil, fmt.Errorf("failed to encode provider specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", SPECIFICATION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SPECIFICATION_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create provider specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider specification", "create"); err != nil {
+		return nil, err
 	}
 
 	sRes := &ProviderSpecification{}
@@ -55,18 +53,17 @@ func (c *NullClient) CreateProviderSpecification(s *ProviderSpecification) (*Pro
 	return sRes, nil
 }
 
-func (c *NullClient) GetProviderSpecification(specId string) (*ProviderSpecification, error) {
+func (c *NullClient) GetProviderSpecification(ctx context.Context, specId string) (*ProviderSpecification, error) {
 	path := fmt.Sprintf("%s/%s", SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get provider specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider specification", "get"); err != nil {
+		return nil, err
 	}
 
 	spec := &ProviderSpecification{}
@@ -77,7 +74,7 @@ func (c *NullClient) GetProviderSpecification(specId string) (*ProviderSpecifica
 	return spec, nil
 }
 
-func (c *NullClient) PatchProviderSpecification(specId string, s *ProviderSpecification) error {
+func (c *NullClient) PatchProviderSpecification(ctx context.Context, specId string, s *ProviderSpecification) error {
 	path := fmt.Sprintf("%s/%s", SPECIFICATION_PATH, specId)
 
 	var buf bytes.Buffer
@@ -85,32 +82,28 @@ func (c *NullClient) PatchProviderSpecification(specId string, s *ProviderSpecif
 		return fmt.Errorf("failed to encode provider specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch provider specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "provider specification", "update")
 }
 
-func (c *NullClient) DeleteProviderSpecification(specId string) error {
+func (c *NullClient) DeleteProviderSpecification(ctx context.Context, specId string) error {
 	path := fmt.Sprintf("%s/%s", SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete provider specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "provider specification", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
@@ -2,8 +2,14 @@ package nullplatform_test
 
 import (
 	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,6 +73,7 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"nullplatform_provider_specification",
 		"nullplatform_artifact",
 		"nullplatform_package",
+		"nullplatform_nrn_namespace",
 	}
 
 	resources := nullplatform.Provider().ResourcesMap
@@ -92,6 +99,8 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"nullplatform_action_specifications",
 		"nullplatform_artifact",
 		"nullplatform_package",
+		"nullplatform_nrn",
+		"nullplatform_nrn_namespace",
 	}
 
 	dataSources := nullplatform.Provider().DataSourcesMap
@@ -143,11 +152,12 @@ func TestProvider_ConfigureResolvesAPIKeyAndHost(t *testing.T) {
 		wantWarnings []string
 	}{
 		{
-			name:       "current attribute wins over the legacy environment variable",
-			config:     map[string]any{nullplatform.API_KEY: "config-key"},
-			env:        map[string]string{"NP_API_KEY": "legacy-env-key"},
-			wantAPIKey: "config-key",
-			wantHost:   nullplatform.DEFAULT_HOST,
+			name:         "current attribute wins over the legacy environment variable",
+			config:       map[string]any{nullplatform.API_KEY: "config-key"},
+			env:          map[string]string{"NP_API_KEY": "legacy-env-key"},
+			wantAPIKey:   "config-key",
+			wantHost:     nullplatform.DEFAULT_HOST,
+			wantWarnings: []string{"Multiple Sources for Credentials"},
 		},
 		{
 			name:       "current environment variables",
@@ -179,6 +189,9 @@ func TestProvider_ConfigureResolvesAPIKeyAndHost(t *testing.T) {
 			for _, name := range envNames {
 				t.Setenv(name, tc.env[name])
 			}
+			// Keep a config file on the test host out of the picture.
+			t.Setenv("NULLPLATFORM_PROFILE", "")
+			t.Setenv("NULLPLATFORM_CONFIG_FILE", filepath.Join(t.TempDir(), "missing"))
 
 			p := provider()
 			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
@@ -199,7 +212,7 @@ func TestProvider_ConfigureResolvesAPIKeyAndHost(t *testing.T) {
 }
 
 func TestProvider_ConfigureFailsWithoutAPIKey(t *testing.T) {
-	for _, name := range []string{"NULLPLATFORM_API_KEY", "NP_API_KEY"} {
+	for _, name := range []string{"NULLPLATFORM_API_KEY", "NP_API_KEY", "NULLPLATFORM_ACCESS_TOKEN", "NULLPLATFORM_TOKEN_FILE"} {
 		t.Setenv(name, "")
 	}
 
@@ -208,3 +221,408 @@ func TestProvider_ConfigureFailsWithoutAPIKey(t *testing.T) {
 	require.True(t, diags.HasError())
 	require.Equal(t, "Missing API Key", diags[0].Summary)
 }
+
+func TestProvider_ConfigureTokenSources(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+
+	cases := []struct {
+		name      string
+		config    map[string]any
+		env       map[string]string
+		wantToken string
+	}{
+		{
+			name:      "access_token",
+			config:    map[string]any{nullplatform.ACCESS_TOKEN: "static-token"},
+			wantToken: "static-token",
+		},
+		{
+			name:      "access_token from the environment",
+			env:       map[string]string{"NULLPLATFORM_ACCESS_TOKEN": "env-token"},
+			wantToken: "env-token",
+		},
+		{
+			name:      "token_file",
+			config:    map[string]any{nullplatform.TOKEN_FILE: tokenFile},
+			wantToken: "file-token",
+		},
+		{
+			name: "exec",
+			config: map[string]any{nullplatform.EXEC: []any{map[string]any{
+				"command": "sh",
+				"args":    []any{"-c", `echo '{"token":"exec-token"}'`},
+			}}},
+			wantToken: "exec-token",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// No API key is needed when a token source is configured.
+			for _, name := range []string{"NULLPLATFORM_API_KEY", "NP_API_KEY", "NULLPLATFORM_ACCESS_TOKEN", "NULLPLATFORM_TOKEN_FILE"} {
+				t.Setenv(name, tc.env[name])
+			}
+
+			p := provider()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
+			require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+			client := p.Meta().(*nullplatform.NullClient)
+			require.Empty(t, client.ApiKey)
+			require.NotNil(t, client.TokenSource)
+
+			token, err := client.TokenSource.Token(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.wantToken, token.AccessToken)
+		})
+	}
+}
+
+func TestProvider_ConfigureRejectsSeveralTokenSources(t *testing.T) {
+	t.Setenv("NULLPLATFORM_TOKEN_FILE", "")
+	t.Setenv("NULLPLATFORM_ACCESS_TOKEN", "env-token")
+
+	diags := provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.TOKEN_FILE: "/var/run/nullplatform/token",
+	}))
+
+	require.True(t, diags.HasError())
+	require.Equal(t, "Conflicting Authentication Methods", diags[0].Summary)
+	require.Contains(t, diags[0].Detail, "access_token, token_file")
+}
+
+func TestProvider_ConfigureProfiles(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(configFile, []byte(`
+# Used when no profile is selected.
+[default]
+host    = default.nullplatform.com
+api_key = default-key
+
+[staging]
+host         = staging.nullplatform.com
+access_token = staging-token
+nrn          = organization=42:account=7
+`), 0o600))
+
+	cases := []struct {
+		name         string
+		config       map[string]any
+		env          map[string]string
+		wantAPIKey   string
+		wantToken    string
+		wantHost     string
+		wantWarnings []string
+	}{
+		{
+			name:         "default profile",
+			wantAPIKey:   "default-key",
+			wantHost:     "default.nullplatform.com",
+			wantWarnings: []string{"Setting Read From Profile", "Setting Read From Profile"},
+		},
+		{
+			name:         "selected profile",
+			config:       map[string]any{nullplatform.PROFILE: "staging"},
+			wantToken:    "staging-token",
+			wantHost:     "staging.nullplatform.com",
+			wantWarnings: []string{"Setting Read From Profile", "Setting Read From Profile", "Setting Read From Profile"},
+		},
+		{
+			name:         "profile selected through the environment",
+			env:          map[string]string{"NULLPLATFORM_PROFILE": "staging"},
+			wantToken:    "staging-token",
+			wantHost:     "staging.nullplatform.com",
+			wantWarnings: []string{"Setting Read From Profile", "Setting Read From Profile", "Setting Read From Profile"},
+		},
+		{
+			name:         "environment takes precedence over the default profile",
+			env:          map[string]string{"NULLPLATFORM_API_KEY": "env-key"},
+			wantAPIKey:   "env-key",
+			wantHost:     "default.nullplatform.com",
+			wantWarnings: []string{"Multiple Sources for Credentials", "Setting Read From Profile"},
+		},
+		{
+			name:         "selected profile takes precedence over the environment and attributes over the profile",
+			config:       map[string]any{nullplatform.PROFILE: "staging", nullplatform.HOST: "custom.nullplatform.com"},
+			env:          map[string]string{"NULLPLATFORM_API_KEY": "env-key"},
+			wantToken:    "staging-token",
+			wantHost:     "custom.nullplatform.com",
+			wantWarnings: []string{"Multiple Sources for Host", "Environment Variables Ignored", "Setting Read From Profile", "Setting Read From Profile"},
+		},
+		{
+			name:         "profile takes precedence over deprecated variables",
+			env:          map[string]string{"NP_API_KEY": "legacy-key", "NP_API_HOST": "legacy.nullplatform.com"},
+			wantAPIKey:   "default-key",
+			wantHost:     "default.nullplatform.com",
+			wantWarnings: []string{"Multiple Sources for Credentials", "Multiple Sources for Host", "Setting Read From Profile", "Setting Read From Profile"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{"NULLPLATFORM_API_KEY", "NULLPLATFORM_HOST", "NP_API_KEY", "NP_API_HOST", "NULLPLATFORM_ACCESS_TOKEN", "NULLPLATFORM_TOKEN_FILE", "NULLPLATFORM_PROFILE"} {
+				t.Setenv(name, tc.env[name])
+			}
+			t.Setenv("NULLPLATFORM_CONFIG_FILE", configFile)
+
+			p := provider()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
+			require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+			client := p.Meta().(*nullplatform.NullClient)
+			require.Equal(t, tc.wantAPIKey, client.ApiKey)
+			require.Equal(t, tc.wantHost, client.ApiURL)
+			if tc.wantToken != "" {
+				require.NotNil(t, client.TokenSource)
+				token, err := client.TokenSource.Token(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, tc.wantToken, token.AccessToken)
+			}
+
+			summaries := make([]string, 0, len(diags))
+			for _, d := range diags {
+				summaries = append(summaries, d.Summary)
+			}
+			require.ElementsMatch(t, tc.wantWarnings, summaries)
+		})
+	}
+}
+
+func TestProvider_ConfigureWarnsAboutOverriddenSources(t *testing.T) {
+	for _, name := range []string{"NULLPLATFORM_HOST", "NP_API_KEY", "NP_API_HOST", "NULLPLATFORM_TOKEN_FILE", "NULLPLATFORM_ORGANIZATION_ID", "NULLPLATFORM_PROFILE"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+	t.Setenv("NULLPLATFORM_ACCESS_TOKEN", "env-token")
+	t.Setenv("NULLPLATFORM_CONFIG_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	p := provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.API_KEY: "config-key",
+	}))
+	require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+	client := p.Meta().(*nullplatform.NullClient)
+	require.NotNil(t, client.TokenSource, "the access token takes precedence over the API key")
+
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Equal(t, "Multiple Sources for Credentials", diags[0].Summary)
+	require.Equal(t, "Credentials set by more than one source: using NULLPLATFORM_ACCESS_TOKEN, ignoring 'api_key', NULLPLATFORM_API_KEY.", diags[0].Detail)
+}
+
+func TestProvider_ConfigureProfileErrors(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(configFile, []byte("[default]\napi_key = key\n\n[local]\nhost = localhost\n"), 0o600))
+
+	cases := []struct {
+		name        string
+		profile     string
+		configFile  string
+		wantSummary string
+	}{
+		{name: "unknown profile", profile: "prod", configFile: configFile, wantSummary: "Profile Not Found"},
+		{name: "missing config file", profile: "prod", configFile: filepath.Join(t.TempDir(), "missing"), wantSummary: "Invalid Config File"},
+		{name: "selected profile without credentials ignores the environment", profile: "local", configFile: configFile, wantSummary: "Missing API Key"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+			t.Setenv("NULLPLATFORM_CONFIG_FILE", tc.configFile)
+
+			diags := provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+				nullplatform.PROFILE: tc.profile,
+			}))
+
+			require.True(t, diags.HasError())
+			require.Equal(t, tc.wantSummary, diags[0].Summary)
+		})
+	}
+}
+
+func TestProvider_ConfigureOrganizationID(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(configFile, []byte("[default]\nnrn = organization=42:account=7\n"), 0o600))
+
+	cases := []struct {
+		name        string
+		config      map[string]any
+		configFile  string
+		wantOrgID   string
+		wantWarning bool
+	}{
+		{name: "not configured", configFile: filepath.Join(t.TempDir(), "missing")},
+		{name: "attribute", config: map[string]any{nullplatform.ORGANIZATION_ID: "5"}, configFile: filepath.Join(t.TempDir(), "missing"), wantOrgID: "5"},
+		{name: "profile nrn", configFile: configFile, wantOrgID: "42", wantWarning: true},
+		{name: "attribute overrides the profile", config: map[string]any{nullplatform.ORGANIZATION_ID: "5"}, configFile: configFile, wantOrgID: "5", wantWarning: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+			t.Setenv("NULLPLATFORM_ORGANIZATION_ID", "")
+			t.Setenv("NULLPLATFORM_PROFILE", "")
+			t.Setenv("NULLPLATFORM_CONFIG_FILE", tc.configFile)
+
+			p := provider()
+			diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
+			require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+			require.Equal(t, tc.wantWarning, len(diags) == 1, "diagnostics: %v", diags)
+
+			require.Equal(t, tc.wantOrgID, p.Meta().(*nullplatform.NullClient).OrganizationID)
+		})
+	}
+}
+
+func TestProvider_ValidatesOrganizationID(t *testing.T) {
+	diags := provider().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.API_KEY:         "key",
+		nullplatform.ORGANIZATION_ID: "organization=1",
+	}))
+
+	require.True(t, diags.HasError())
+}
+
+func TestProvider_ConfigureRetryPolicy(t *testing.T) {
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+
+	p := provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.RETRY: []any{
+			map[string]any{
+				"max_attempts":           6,
+				"min_backoff":            "1s",
+				"max_backoff":            "1m",
+				"retryable_status_codes": []any{429, 503},
+			},
+		},
+	}))
+	require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+	client := p.Meta().(*nullplatform.NullClient)
+	require.Equal(t, 6, client.RetryPolicy.MaxAttempts)
+	require.Equal(t, time.Second, client.RetryPolicy.MinBackoff)
+	require.Equal(t, time.Minute, client.RetryPolicy.MaxBackoff)
+	require.ElementsMatch(t, []int{429, 503}, client.RetryPolicy.RetryableStatusCodes)
+}
+
+func TestProvider_ConfigureDefaultRetryPolicy(t *testing.T) {
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+
+	p := provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{}))
+	require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+	client := p.Meta().(*nullplatform.NullClient)
+	require.Equal(t, nullplatform.DefaultRetryPolicy(), client.RetryPolicy)
+}
+
+func TestProvider_ConfigureRejectsInvertedBackoff(t *testing.T) {
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+
+	diags := provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.RETRY: []any{
+			map[string]any{"min_backoff": "1m", "max_backoff": "1s"},
+		},
+	}))
+
+	require.True(t, diags.HasError())
+}
+
+func TestProvider_ConfigureRequestLimits(t *testing.T) {
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+	t.Setenv("NULLPLATFORM_MAX_CONCURRENT_REQUESTS", "4")
+
+	p := provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.MAX_REQUESTS_PER_SECOND: 2.5,
+	}))
+	require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+	client := p.Meta().(*nullplatform.NullClient)
+	require.Equal(t, 2.5, client.Limiter.RequestsPerSecond())
+	require.Equal(t, 4, client.Limiter.MaxConcurrent())
+}
+
+func TestProvider_ConfigureTransportReachesCustomEndpoint(t *testing.T) {
+	t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+
+	var gotPaths []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, nullplatform.TOKEN_PATH) {
+			_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+	p := provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		nullplatform.BASE_URL:        server.URL + "/api/",
+		nullplatform.CA_BUNDLE_FILES: []any{caFile},
+		nullplatform.REQUEST_TIMEOUT: "5s",
+	}))
+	require.False(t, diags.HasError(), "configure must not fail: %v", diags)
+
+	client := p.Meta().(*nullplatform.NullClient)
+	require.Equal(t, 5*time.Second, client.Client.Timeout)
+
+	res, err := client.MakeRequest(context.Background(), "GET", "/scope/1", nil)
+	require.NoError(t, err, "the custom CA must be trusted")
+	res.Body.Close()
+
+	require.Equal(t, []string{"/api/token", "/api/scope/1"}, gotPaths)
+}
+
+func TestProvider_ConfigureTransportDiagnostics(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      map[string]any
+		wantError   bool
+		wantSummary string
+	}{
+		{
+			name:        "base_url without a scheme",
+			config:      map[string]any{nullplatform.BASE_URL: "api.nullplatform.com"},
+			wantError:   true,
+			wantSummary: "Invalid Base URL",
+		},
+		{
+			name:        "skipping TLS verification warns",
+			config:      map[string]any{nullplatform.INSECURE_SKIP_VERIFY: true},
+			wantSummary: "TLS Verification Disabled",
+		},
+		{
+			name:      "missing CA bundle",
+			config:    map[string]any{nullplatform.CA_BUNDLE_FILES: []any{"/does/not/exist.pem"}},
+			wantError: true,
+		},
+		{
+			name:      "proxy that is not a URL",
+			config:    map[string]any{nullplatform.HTTPS_PROXY: "proxy.internal"},
+			wantError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("NULLPLATFORM_API_KEY", "env-key")
+
+			diags := provider().Configure(context.Background(), terraform.NewResourceConfigRaw(tc.config))
+
+			require.Equal(t, tc.wantError, diags.HasError(), "diagnostics: %v", diags)
+			if tc.wantSummary != "" {
+				require.NotEmpty(t, diags)
+				require.Equal(t, tc.wantSummary, diags[len(diags)-1].Summary)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package nullplatform
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultReadCacheTTL bounds how long a cached lookup is served. One plan or
+// apply rarely takes longer, and every write invalidates what it touches.
+const defaultReadCacheTTL = 5 * time.Minute
+
+// ReadCache is a read-through cache for the GET lookups every resource repeats
+// during one run: slug resolution, action specification lists and snapshot
+// lookups. Entries are keyed by method and path and expire after the TTL. A
+// write through MakeRequest drops every entry that shares a collection with
+// the written path, e.g. a PATCH /scope/12 drops /scope?application_id=3&slug=x.
+// Only successful responses are cached. A nil *ReadCache caches nothing.
+//
+// Lookups whose answer changes without a write from this provider, such as
+// polling the status of an entity, must not go through the cache.
+type ReadCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+	// generation is bumped by every invalidation; a response fetched across
+	// one is handed to its caller but not stored, since it may predate the
+	// write.
+	generation uint64
+}
+
+type readCacheEntry struct {
+	collections map[string]bool
+	expires     time.Time
+	// ready is closed once the response below is filled in; concurrent misses
+	// on the same key wait for the first one instead of sending duplicates.
+	ready  chan struct{}
+	status int
+	header http.Header
+	body   []byte
+	failed bool
+}
+
+func NewReadCache(ttl time.Duration) *ReadCache {
+	return &ReadCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]*readCacheEntry{},
+	}
+}
+
+// TTL returns how long entries are served, 0 for a nil cache.
+func (rc *ReadCache) TTL() time.Duration {
+	if rc == nil {
+		return 0
+	}
+	return rc.ttl
+}
+
+// get returns the cached response for GET path, calling fetch on a miss. The
+// returned response always carries its own copy of the body.
+func (rc *ReadCache) get(ctx context.Context, path string, fetch func() (*http.Response, error)) (*http.Response, error) {
+	if rc == nil {
+		return fetch()
+	}
+
+	key := http.MethodGet + " " + path
+
+	for {
+		rc.mu.Lock()
+		entry, ok := rc.entries[key]
+		if ok && !entry.expired(rc.now()) {
+			rc.mu.Unlock()
+			select {
+			case <-entry.ready:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if entry.failed {
+				// The request in flight did not produce a cacheable
+				// response; try again, this time possibly as the filler.
+				continue
+			}
+			return entry.response(), nil
+		}
+
+		entry = &readCacheEntry{
+			collections: pathCollections(path),
+			expires:     rc.now().Add(rc.ttl),
+			ready:       make(chan struct{}),
+		}
+		rc.entries[key] = entry
+		generation := rc.generation
+		rc.mu.Unlock()
+
+		return rc.fill(key, entry, generation, fetch)
+	}
+}
+
+func (rc *ReadCache) fill(key string, entry *readCacheEntry, generation uint64, fetch func() (*http.Response, error)) (*http.Response, error) {
+	res, err := fetch()
+
+	var body []byte
+	if err == nil {
+		body, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			res = nil
+		} else {
+			res.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if err != nil || res.StatusCode < 200 || res.StatusCode > 299 || rc.generation != generation {
+		entry.failed = true
+		if rc.entries[key] == entry {
+			delete(rc.entries, key)
+		}
+	} else {
+		entry.status = res.StatusCode
+		entry.header = res.Header.Clone()
+		entry.body = body
+	}
+	close(entry.ready)
+
+	return res, err
+}
+
+// invalidate drops every entry sharing a collection with path.
+func (rc *ReadCache) invalidate(path string) {
+	if rc == nil {
+		return
+	}
+
+	written := pathCollections(path)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generation++
+	for key, entry := range rc.entries {
+		for collection := range written {
+			if entry.collections[collection] {
+				delete(rc.entries, key)
+				break
+			}
+		}
+	}
+}
+
+func (e *readCacheEntry) expired(now time.Time) bool {
+	return !now.Before(e.expires)
+}
+
+func (e *readCacheEntry) response() *http.Response {
+	return &http.Response{
+		StatusCode: e.status,
+		Header:     e.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(e.body)),
+	}
+}
+
+// pathCollections returns the collections a path goes through, the segments
+// in collection position of /collection/id/collection/id...:
+// /service_specification/1/action_specification yields service_specification
+// and action_specification.
+func pathCollections(path string) map[string]bool {
+	p, _, _ := strings.Cut(path, "?")
+	if u, err := url.PathUnescape(p); err == nil {
+		p = u
+	}
+
+	collections := map[string]bool{}
+	for i, segment := range strings.Split(strings.Trim(p, "/"), "/") {
+		if i%2 == 0 && segment != "" {
+			collections[segment] = true
+		}
+	}
+	return collections
+}
+
+// getCached is a GET through the read cache.
+func (c *NullClient) getCached(ctx context.Context, path string) (*http.Response, error) {
+	return c.Cache.get(ctx, path, func() (*http.Response, error) {
+		return c.MakeRequest(ctx, http.MethodGet, path, nil)
+	})
+}
@@ -0,0 +1,262 @@
+package nullplatform
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newCountingServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*httptest.Server, *int32) {
+	var gets int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(&gets, 1)
+		}
+		handler(w, r)
+	}))
+	return server, &gets
+}
+
+func readBody(t *testing.T, res *http.Response, err error) string {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func getCachedBody(t *testing.T, client *NullClient, path string) string {
+	t.Helper()
+	res, err := client.getCached(context.Background(), path)
+	return readBody(t, res, err)
+}
+
+func TestReadCache_ServesRepeatedLookups(t *testing.T) {
+	server, gets := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results":[{"id":7}]}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Cache = NewReadCache(time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if body := getCachedBody(t, client, "/account?organization_id=1&slug=main"); body != `{"results":[{"id":7}]}` {
+			t.Fatalf("lookup %d returned %s", i, body)
+		}
+	}
+	if got := atomic.LoadInt32(gets); got != 1 {
+		t.Errorf("sent %d GETs, want 1", got)
+	}
+
+	getCachedBody(t, client, "/account?organization_id=1&slug=other")
+	if got := atomic.LoadInt32(gets); got != 2 {
+		t.Errorf("sent %d GETs, want another one for a different path", got)
+	}
+}
+
+func TestReadCache_Expires(t *testing.T) {
+	server, gets := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	now := time.Now()
+	client := newTestClient(server)
+	client.Cache = NewReadCache(time.Minute)
+	client.Cache.now = func() time.Time { return now }
+
+	getCachedBody(t, client, "/scope?slug=a")
+	now = now.Add(59 * time.Second)
+	getCachedBody(t, client, "/scope?slug=a")
+	now = now.Add(time.Second)
+	getCachedBody(t, client, "/scope?slug=a")
+
+	if got := atomic.LoadInt32(gets); got != 2 {
+		t.Errorf("sent %d GETs, want 2 (one before and one after the TTL)", got)
+	}
+}
+
+func TestReadCache_WritesInvalidateTheirCollections(t *testing.T) {
+	server, gets := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Cache = NewReadCache(time.Minute)
+	ctx := context.Background()
+
+	lookups := []string{
+		"/scope?application_id=3&slug=main",
+		"/service_specification/1/action_specification",
+		"/action_specification/5/snapshots",
+		"/account?organization_id=1&slug=main",
+	}
+	for _, path := range lookups {
+		getCachedBody(t, client, path)
+	}
+
+	// Drops the action specification list and its snapshots, keeps the
+	// scope and account lookups.
+	res, err := client.MakeRequest(ctx, http.MethodPatch, "/service_specification/1/action_specification/5", nil)
+	readBody(t, res, err)
+	// Drops the scope lookup.
+	res, err = client.MakeRequest(ctx, http.MethodDelete, "/scope/12", nil)
+	readBody(t, res, err)
+
+	before := atomic.LoadInt32(gets)
+	for _, path := range lookups {
+		getCachedBody(t, client, path)
+	}
+	if got := atomic.LoadInt32(gets) - before; got != 3 {
+		t.Errorf("sent %d GETs after the writes, want 3 (everything but the account lookup)", got)
+	}
+}
+
+func TestReadCache_DoesNotCacheFailures(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	server, gets := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Cache = NewReadCache(time.Minute)
+	ctx := context.Background()
+
+	res, err := client.getCached(ctx, "/namespace?slug=a")
+	if err != nil || res.StatusCode != http.StatusNotFound {
+		t.Fatalf("getCached() = %v, %v, want the 404 handed back", res, err)
+	}
+	res.Body.Close()
+
+	fail.Store(false)
+	getCachedBody(t, client, "/namespace?slug=a")
+	getCachedBody(t, client, "/namespace?slug=a")
+
+	if got := atomic.LoadInt32(gets); got != 2 {
+		t.Errorf("sent %d GETs, want the 404 retried once and the success cached", got)
+	}
+}
+
+func TestReadCache_ConcurrentMissesShareOneRequest(t *testing.T) {
+	release := make(chan struct{})
+	server, gets := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"id":1}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Cache = NewReadCache(time.Minute)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.getCached(ctx, "/application?slug=a")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			bodies[i] = string(body)
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(gets); got != 1 {
+		t.Errorf("sent %d GETs, want 1", got)
+	}
+	for i, body := range bodies {
+		if body != `{"id":1}` {
+			t.Errorf("caller %d got %q", i, body)
+		}
+	}
+}
+
+func TestReadCache_DoesNotStoreResponsesFetchedAcrossAWrite(t *testing.T) {
+	rc := NewReadCache(time.Minute)
+	ctx := context.Background()
+
+	fetches := 0
+	fetch := func() (*http.Response, error) {
+		fetches++
+		if fetches == 1 {
+			// A write to the same collection lands while the lookup is in
+			// flight.
+			rc.invalidate("/scope/1")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(http.NoBody)}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := rc.get(ctx, "/scope?slug=a", fetch)
+		readBody(t, res, err)
+	}
+	if fetches != 2 {
+		t.Errorf("fetched %d times, want the response read across the write not cached", fetches)
+	}
+}
+
+func TestReadCache_Nil(t *testing.T) {
+	var rc *ReadCache
+	rc.invalidate("/scope/1")
+
+	fetches := 0
+	for i := 0; i < 2; i++ {
+		res, err := rc.get(context.Background(), "/scope?slug=a", func() (*http.Response, error) {
+			fetches++
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(http.NoBody)}, nil
+		})
+		readBody(t, res, err)
+	}
+	if fetches != 2 {
+		t.Errorf("fetched %d times, want a nil cache to pass every lookup through", fetches)
+	}
+}
+
+func TestPathCollections(t *testing.T) {
+	tests := map[string][]string{
+		"/scope":                            {"scope"},
+		"/scope/12":                         {"scope"},
+		"/scope?application_id=3":           {"scope"},
+		"/service/1/action/2":               {"service", "action"},
+		"/action_specification/5/snapshots": {"action_specification", "snapshots"},
+	}
+	for path, want := range tests {
+		got := pathCollections(path)
+		if len(got) != len(want) {
+			t.Errorf("pathCollections(%s) = %v, want %v", path, got, want)
+			continue
+		}
+		for _, c := range want {
+			if !got[c] {
+				t.Errorf("pathCollections(%s) = %v, want %v", path, got, want)
+			}
+		}
+	}
+}
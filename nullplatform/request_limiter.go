@@ -0,0 +1,141 @@
+package nullplatform
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// RequestLimiter throttles the calls MakeRequest sends: a token bucket caps the
+// request rate and a semaphore caps how many requests are in flight at once.
+// Either limit is disabled when configured as 0. A nil *RequestLimiter lets
+// every request through.
+type RequestLimiter struct {
+	bucket *tokenBucket
+	slots  chan struct{}
+}
+
+func NewRequestLimiter(requestsPerSecond float64, maxConcurrent int) *RequestLimiter {
+	l := &RequestLimiter{}
+	if requestsPerSecond > 0 {
+		l.bucket = newTokenBucket(requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// RequestsPerSecond returns the configured rate limit, 0 when unlimited.
+func (l *RequestLimiter) RequestsPerSecond() float64 {
+	if l == nil || l.bucket == nil {
+		return 0
+	}
+	return l.bucket.rate
+}
+
+// MaxConcurrent returns the configured in-flight limit, 0 when unlimited.
+func (l *RequestLimiter) MaxConcurrent() int {
+	if l == nil || l.slots == nil {
+		return 0
+	}
+	return cap(l.slots)
+}
+
+// acquire blocks until a request may be sent or ctx is done. On success the
+// returned release func must be called exactly once when the request is over.
+func (l *RequestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if l.bucket != nil {
+		if err := l.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+// tokenBucket refills at rate tokens per second up to burst. Callers reserve a
+// token up front and sleep for the deficit, so waiters are served in arrival
+// order instead of racing for each refill.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, math.Floor(rate))
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	deficit := -b.tokens
+	b.mu.Unlock()
+
+	if deficit <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Duration(deficit / b.rate * float64(time.Second)))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Hand the reservation back so cancelled requests do not slow down
+		// the ones still waiting.
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// releasingBody gives the concurrency slot back once the caller is done with
+// the response, not when MakeRequest returns, so a slow body read still counts
+// as in flight.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,159 @@
+package nullplatform
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiter_BoundsConcurrentRequests(t *testing.T) {
+	const maxConcurrent = 3
+
+	var inFlight, peak int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Limiter = NewRequestLimiter(0, maxConcurrent)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+			if err != nil {
+				t.Errorf("MakeRequest returned unexpected error: %v", err)
+				return
+			}
+			res.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&peak); got > maxConcurrent {
+		t.Errorf("peak in-flight requests = %d, want at most %d", got, maxConcurrent)
+	}
+}
+
+func TestRequestLimiter_HoldsSlotUntilBodyIsClosed(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Limiter = NewRequestLimiter(0, 1)
+
+	res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("MakeRequest returned unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire with an open body = %v, want context.DeadlineExceeded", err)
+	}
+
+	res.Body.Close()
+	res.Body.Close()
+
+	release, err := client.Limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire after closing the body returned %v", err)
+	}
+	release()
+}
+
+func TestRequestLimiter_RateLimitsRequests(t *testing.T) {
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Limiter = NewRequestLimiter(20, 0)
+
+	start := time.Now()
+	for i := 0; i < 11; i++ {
+		res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+		if err != nil {
+			t.Fatalf("MakeRequest returned unexpected error: %v", err)
+		}
+		res.Body.Close()
+	}
+	elapsed := time.Since(start)
+
+	// A burst of 20 tokens is available up front, so drain it first.
+	if elapsed > 400*time.Millisecond {
+		t.Errorf("11 requests within the burst took %s, want no throttling", elapsed)
+	}
+
+	start = time.Now()
+	for i := 0; i < 20; i++ {
+		res, err := client.MakeRequest(context.Background(), "GET", "/test", nil)
+		if err != nil {
+			t.Fatalf("MakeRequest returned unexpected error: %v", err)
+		}
+		res.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Errorf("20 requests past the burst took %s, want them spread at 20/s", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 31 {
+		t.Errorf("requests = %d, want 31", got)
+	}
+}
+
+func TestTokenBucket_WaitRespectsCancellation(t *testing.T) {
+	bucket := newTokenBucket(1)
+	if err := bucket.wait(context.Background()); err != nil {
+		t.Fatalf("first wait returned %v, want the burst token", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := bucket.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wait = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("cancelled wait took %s, want it to return as soon as the context is done", elapsed)
+	}
+
+	bucket.mu.Lock()
+	tokens := bucket.tokens
+	bucket.mu.Unlock()
+	if tokens < -0.5 {
+		t.Errorf("tokens = %f, want the cancelled reservation handed back", tokens)
+	}
+}
+
+func TestRequestLimiter_NilLetsEverythingThrough(t *testing.T) {
+	var l *RequestLimiter
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire on a nil limiter returned %v", err)
+	}
+	release()
+}
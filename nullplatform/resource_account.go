@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,10 +14,10 @@ func resourceAccount() *schema.Resource {
 	return &schema.Resource{
 		Description: "The account resource allows you to configure a nullplatform account",
 
-		Create: AccountCreate,
-		Read:   AccountRead,
-		Update: AccountUpdate,
-		Delete: AccountDelete,
+		CreateContext: AccountCreate,
+		ReadContext:   AccountRead,
+		UpdateContext: AccountUpdate,
+		DeleteContext: AccountDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -68,25 +69,25 @@ func resourceAccount() *schema.Resource {
 	}
 }
 
-func AccountCreate(d *schema.ResourceData, m any) error {
+func AccountCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	client := nullOps.(*NullClient)
 
-	organizationIDStr, err := client.GetOrganizationIDFromToken()
+	organizationIDStr, err := client.GetOrganizationID(ctx)
 	if err != nil {
-		return fmt.Errorf("error getting organization ID from token: %w", err)
+		return diagFromErr(fmt.Errorf("error getting organization ID: %w", err))
 	}
 
 	organizationID, err := strconv.Atoi(organizationIDStr)
 
 	if err != nil {
-		return fmt.Errorf("error getting organization ID from token: %w", err)
+		return diagFromErr(fmt.Errorf("error getting organization ID: %w", err))
 	}
 
 	settingsJSON := d.Get("settings").(string)
 	var settings map[string]interface{}
 	if err := json.Unmarshal([]byte(settingsJSON), &settings); err != nil {
-		return fmt.Errorf("error parsing settings JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error parsing settings JSON: %v", err))
 	}
 
 	newAccount := &Account{
@@ -98,64 +99,61 @@ func AccountCreate(d *schema.ResourceData, m any) error {
 		Settings:           settings,
 	}
 
-	account, err := nullOps.CreateAccount(newAccount)
+	account, adoptedAfter, err := nullOps.CreateAccount(ctx, newAccount)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(account.Id))
+	diags := adoptionWarning("account", adoptedAfter)
 
 	if err := d.Set("organization_id", account.OrganizationId); err != nil {
-		return fmt.Errorf("error setting organization_id: %w", err)
+		return append(diags, diagFromErr(fmt.Errorf("error setting organization_id: %w", err))...)
 	}
 
-	return AccountRead(d, m)
+	return append(diags, AccountRead(ctx, d, m)...)
 }
 
-func AccountRead(d *schema.ResourceData, m any) error {
+func AccountRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	accountId := d.Id()
 
-	account, err := nullOps.GetAccount(accountId)
+	account, err := nullOps.GetAccount(ctx, accountId)
 	if err != nil {
-		if account != nil && (account.Status == "inactive" || account.Status == "deleted") {
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", account.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("organization_id", account.OrganizationId); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("repository_prefix", account.RepositoryPrefix); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("repository_provider", account.RepositoryProvider); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", account.Slug); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("nrn", account.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	settingsJSON, err := json.Marshal(account.Settings)
 	if err != nil {
-		return fmt.Errorf("error serializing settings to JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error serializing settings to JSON: %v", err))
 	}
 	if err := d.Set("settings", string(settingsJSON)); err != nil {
-		return fmt.Errorf("error setting settings in state: %v", err)
+		return diagFromErr(fmt.Errorf("error setting settings in state: %v", err))
 	}
 
 	return nil
 }
 
-func AccountUpdate(d *schema.ResourceData, m any) error {
+func AccountUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	accountId := d.Id()
 
@@ -177,26 +175,26 @@ func AccountUpdate(d *schema.ResourceData, m any) error {
 		settingsJSON := d.Get("settings").(string)
 		var settings map[string]interface{}
 		if err := json.Unmarshal([]byte(settingsJSON), &settings); err != nil {
-			return fmt.Errorf("error parsing settings JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error parsing settings JSON: %v", err))
 		}
 		account.Settings = settings
 	}
 
-	err := nullOps.PatchAccount(accountId, account)
+	err := nullOps.PatchAccount(ctx, accountId, account)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return AccountRead(d, m)
+	return AccountRead(ctx, d, m)
 }
 
-func AccountDelete(d *schema.ResourceData, m any) error {
+func AccountDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	accountId := d.Id()
 
-	err := nullOps.DeleteAccount(accountId)
+	err := nullOps.DeleteAccount(ctx, accountId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -64,7 +65,7 @@ func testAccCheckAccountExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundAccount, err := client.GetAccount(rs.Primary.ID)
+		foundAccount, err := client.GetAccount(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -88,7 +89,7 @@ func testAccCheckAccountDestroy(s *terraform.State) error {
 			continue
 		}
 
-		account, err := client.GetAccount(rs.Primary.ID)
+		account, err := client.GetAccount(context.Background(), rs.Primary.ID)
 		if err == nil && account != nil {
 			return fmt.Errorf("Account with ID %s still exists and was not deleted", rs.Primary.ID)
 		}
@@ -132,13 +132,13 @@ func ActionSpecificationCreate(ctx context.Context, d *schema.ResourceData, m in
 	parametersStr := d.Get("parameters").(string)
 	var parameters map[string]interface{}
 	if err := json.Unmarshal([]byte(parametersStr), &parameters); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
 	}
 
 	resultsStr := d.Get("results").(string)
 	var results map[string]interface{}
 	if err := json.Unmarshal([]byte(resultsStr), &results); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing results JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing results JSON: %v", err))
 	}
 
 	spec := &ActionSpecification{
@@ -159,7 +159,7 @@ func ActionSpecificationCreate(ctx context.Context, d *schema.ResourceData, m in
 	if annotationsStr, ok := d.GetOk("annotations"); ok {
 		var annotations map[string]interface{}
 		if err := json.Unmarshal([]byte(annotationsStr.(string)), &annotations); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing annotations JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing annotations JSON: %v", err))
 		}
 		spec.Annotations = annotations
 	}
@@ -168,14 +168,14 @@ func ActionSpecificationCreate(ctx context.Context, d *schema.ResourceData, m in
 	if externalStr, ok := d.GetOk("external"); ok {
 		var external map[string]interface{}
 		if err := json.Unmarshal([]byte(externalStr.(string)), &external); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing external JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing external JSON: %v", err))
 		}
 		spec.External = external
 	}
 
-	newSpec, err := nullOps.CreateActionSpecification(spec)
+	newSpec, err := nullOps.CreateActionSpecification(ctx, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newSpec.Id)
@@ -196,92 +196,92 @@ func ActionSpecificationRead(ctx context.Context, d *schema.ResourceData, m inte
 		parentId = d.Get("link_specification_id").(string)
 	}
 
-	spec, err := nullOps.GetActionSpecification(specId, parentType, parentId)
+	spec, err := nullOps.GetActionSpecification(ctx, specId, parentType, parentId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", spec.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Best-effort newest snapshot id, for pinning into a package BOM.
-	if snapshotID, snapErr := nullOps.GetLatestSnapshotID("action_specification", specId); snapErr == nil {
+	if snapshotID, snapErr := nullOps.GetLatestSnapshotID(ctx, "action_specification", specId); snapErr == nil {
 		if err := d.Set("last_snapshot_id", snapshotID); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if err := d.Set("type", spec.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("service_specification_id", spec.ServiceSpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("link_specification_id", spec.LinkSpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("retryable", spec.Retryable); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("parallelize", spec.Parallelize); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("enabled_when", spec.EnabledWhen); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	parametersJSON, err := json.Marshal(spec.Parameters)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
 	}
 	if err := d.Set("parameters", string(parametersJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	resultsJSON, err := json.Marshal(spec.Results)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing results to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing results to JSON: %v", err))
 	}
 	if err := d.Set("results", string(resultsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("icon", spec.Icon); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if spec.Annotations != nil {
 		annotationsJSON, err := json.Marshal(spec.Annotations)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing annotations to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing annotations to JSON: %v", err))
 		}
 		if err := d.Set("annotations", string(annotationsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if spec.External != nil {
 		externalJSON, err := json.Marshal(spec.External)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing external to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing external to JSON: %v", err))
 		}
 		if err := d.Set("external", string(externalJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if spec.ExternalResolution != nil {
 		externalResolutionJSON, err := json.Marshal(spec.ExternalResolution)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing external_resolution to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing external_resolution to JSON: %v", err))
 		}
 		if err := d.Set("external_resolution", string(externalResolutionJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -321,7 +321,7 @@ func ActionSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		parametersStr := d.Get("parameters").(string)
 		var parameters map[string]interface{}
 		if err := json.Unmarshal([]byte(parametersStr), &parameters); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
 		}
 		spec.Parameters = parameters
 	}
@@ -330,7 +330,7 @@ func ActionSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		resultsStr := d.Get("results").(string)
 		var results map[string]interface{}
 		if err := json.Unmarshal([]byte(resultsStr), &results); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing results JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing results JSON: %v", err))
 		}
 		spec.Results = results
 	}
@@ -355,7 +355,7 @@ func ActionSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		if annotationsStr, ok := d.GetOk("annotations"); ok {
 			var annotations map[string]interface{}
 			if err := json.Unmarshal([]byte(annotationsStr.(string)), &annotations); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing annotations JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing annotations JSON: %v", err))
 			}
 			spec.Annotations = annotations
 		} else {
@@ -367,7 +367,7 @@ func ActionSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		if externalStr, ok := d.GetOk("external"); ok {
 			var external map[string]interface{}
 			if err := json.Unmarshal([]byte(externalStr.(string)), &external); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing external JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing external JSON: %v", err))
 			}
 			spec.External = external
 		} else {
@@ -375,9 +375,9 @@ func ActionSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		}
 	}
 
-	err := nullOps.PatchActionSpecification(specId, spec, parentType, parentId)
+	err := nullOps.PatchActionSpecification(ctx, specId, spec, parentType, parentId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return ActionSpecificationRead(ctx, d, m)
@@ -398,9 +398,9 @@ func ActionSpecificationDelete(ctx context.Context, d *schema.ResourceData, m in
 		parentId = d.Get("link_specification_id").(string)
 	}
 
-	err := nullOps.DeleteActionSpecification(specId, parentType, parentId)
+	err := nullOps.DeleteActionSpecification(ctx, specId, parentType, parentId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceApiKey() *schema.Resource {
@@ -54,10 +53,10 @@ func resourceApiKey() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"nrn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "The NRN for the grant.",
-							ValidateFunc: validation.StringIsNotEmpty,
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      "The NRN for the grant.",
+							ValidateDiagFunc: validateNRN,
 						},
 						"role_id": {
 							Type:        schema.TypeInt,
@@ -112,7 +111,7 @@ func resourceApiKey() *schema.Resource {
 	}
 }
 
-func ReadApiKey(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func ReadApiKey(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	apiKeyId, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -120,9 +119,9 @@ func ReadApiKey(_ context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 		return diag.Errorf("failed to parse API key ID: %v", err)
 	}
 
-	apiKey, err := nullOps.GetApiKey(apiKeyId)
+	apiKey, err := nullOps.GetApiKey(ctx, apiKeyId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	rawContent := map[string]any{
@@ -141,7 +140,7 @@ func ReadApiKey(_ context.Context, d *schema.ResourceData, m any) diag.Diagnosti
 
 	for k, v := range rawContent {
 		if err := d.Set(k, v); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -160,9 +159,9 @@ func CreateApiKey(ctx context.Context, d *schema.ResourceData, m any) diag.Diagn
 		body.Tags = tags
 	}
 
-	apiKey, err := nullOps.CreateApiKey(&body)
+	apiKey, err := nullOps.CreateApiKey(ctx, &body)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	apiKeyId := strconv.FormatInt(apiKey.ID, 10)
@@ -199,15 +198,15 @@ func UpdateApiKey(ctx context.Context, d *schema.ResourceData, m any) diag.Diagn
 		return diag.Errorf("failed to parse API key ID: %v", err)
 	}
 
-	err = nullOps.PatchApiKey(apiKeyId, &body)
+	err = nullOps.PatchApiKey(ctx, apiKeyId, &body)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return ReadApiKey(ctx, d, m)
 }
 
-func DeleteApiKey(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func DeleteApiKey(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	apiKeyId, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -215,9 +214,9 @@ func DeleteApiKey(_ context.Context, d *schema.ResourceData, m any) diag.Diagnos
 		return diag.Errorf("failed to parse API key ID: %v", err)
 	}
 
-	err = nullOps.DeleteApiKey(apiKeyId)
+	err = nullOps.DeleteApiKey(ctx, apiKeyId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -123,7 +123,7 @@ func ApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	if tagsStr, ok := d.GetOk("tags"); ok {
 		var tags map[string]interface{}
 		if err := json.Unmarshal([]byte(tagsStr.(string)), &tags); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing tags JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing tags JSON: %v", err))
 		}
 		newApp.Tags = tags
 	}
@@ -131,87 +131,87 @@ func ApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	if settingsStr, ok := d.GetOk("settings"); ok {
 		var settings map[string]interface{}
 		if err := json.Unmarshal([]byte(settingsStr.(string)), &settings); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing settings JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing settings JSON: %v", err))
 		}
 		newApp.Settings = settings
 	}
 
-	app, err := nullOps.CreateApplication(newApp)
+	app, adoptedAfter, err := nullOps.CreateApplication(ctx, newApp)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(app.Id))
-	return ApplicationRead(ctx, d, m)
+	return append(adoptionWarning("application", adoptedAfter), ApplicationRead(ctx, d, m)...)
 }
 
 func ApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	appId := d.Id()
 
-	app, err := nullOps.GetApplication(appId)
+	app, err := nullOps.GetApplication(ctx, appId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", app.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("namespace_id", app.NamespaceId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("repository_url", app.RepositoryUrl); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", app.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", app.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("template_id", app.TemplateId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("auto_deploy_on_creation", app.AutoDeployOnCreation); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("repository_app_path", app.RepositoryAppPath); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("is_mono_repo", app.IsMonoRepo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("nrn", app.Nrn); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if app.Tags != nil {
 		tagsJSON, err := json.Marshal(app.Tags)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing tags to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing tags to JSON: %v", err))
 		}
 		if err := d.Set("tags", string(tagsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if app.Settings != nil {
 		settingsJSON, err := json.Marshal(app.Settings)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing settings to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing settings to JSON: %v", err))
 		}
 		if err := d.Set("settings", string(settingsJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if app.Messages != nil {
 		messagesJSON, err := json.Marshal(app.Messages)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing messages to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing messages to JSON: %v", err))
 		}
 		if err := d.Set("messages", string(messagesJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -240,7 +240,7 @@ func ApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		if tagsStr, ok := d.GetOk("tags"); ok {
 			var tags map[string]interface{}
 			if err := json.Unmarshal([]byte(tagsStr.(string)), &tags); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing tags JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing tags JSON: %v", err))
 			}
 			app.Tags = tags
 		}
@@ -249,14 +249,14 @@ func ApplicationUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		if settingsStr, ok := d.GetOk("settings"); ok {
 			var settings map[string]interface{}
 			if err := json.Unmarshal([]byte(settingsStr.(string)), &settings); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing settings JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing settings JSON: %v", err))
 			}
 			app.Settings = settings
 		}
 	}
 
-	if err := nullOps.PatchApplication(appId, app); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.PatchApplication(ctx, appId, app); err != nil {
+		return diagFromErr(err)
 	}
 
 	return ApplicationRead(ctx, d, m)
@@ -266,8 +266,8 @@ func ApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{
 	nullOps := m.(NullOps)
 	appId := d.Id()
 
-	if err := nullOps.DeleteApplication(appId); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteApplication(ctx, appId); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,10 +14,11 @@ func resourceApprovalAction() *schema.Resource {
 	return &schema.Resource{
 		Description: "The approval action resource allows you to configure a nullplatform action for the approval workflow",
 
-		Create: ApprovalActionCreate,
-		Read:   ApprovalActionRead,
-		Update: ApprovalActionUpdate,
-		Delete: ApprovalActionDelete,
+		CreateContext: ApprovalActionCreate,
+		ReadContext:   ApprovalActionRead,
+		UpdateContext: ApprovalActionUpdate,
+		DeleteContext: ApprovalActionDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -45,6 +47,7 @@ func resourceApprovalAction() *schema.Resource {
 				},
 				Description: "A key-value map with the runtime configuration dimensions that apply to this scope.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 			"on_policy_success": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -65,7 +68,7 @@ func resourceApprovalAction() *schema.Resource {
 	}
 }
 
-func ApprovalActionCreate(d *schema.ResourceData, m any) error {
+func ApprovalActionCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -73,9 +76,9 @@ func ApprovalActionCreate(d *schema.ResourceData, m any) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 	entity := d.Get("entity").(string)
@@ -84,12 +87,7 @@ func ApprovalActionCreate(d *schema.ResourceData, m any) error {
 	onPolicyFail := d.Get("on_policy_fail").(string)
 	policies := d.Get("policies").(*schema.Set)
 
-	dimensionsMap := d.Get("dimensions").(map[string]any)
-	// Convert the dimensions to a map[string]string
-	dimensions := make(map[string]string)
-	for key, value := range dimensionsMap {
-		dimensions[key] = value.(string)
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	newApprovalAction := &ApprovalAction{
 		Nrn:             nrn,
@@ -100,59 +98,55 @@ func ApprovalActionCreate(d *schema.ResourceData, m any) error {
 		OnPolicyFail:    onPolicyFail,
 	}
 
-	approvalAction, err := nullOps.CreateApprovalAction(newApprovalAction)
+	approvalAction, err := nullOps.CreateApprovalAction(ctx, newApprovalAction)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	approvalActionId := strconv.Itoa(approvalAction.Id)
 	d.SetId(approvalActionId)
 
 	for _, policyId := range policies.List() {
-		err := nullOps.AssociatePolicyWithAction(approvalActionId, policyId.(string))
+		err := nullOps.AssociatePolicyWithAction(ctx, approvalActionId, policyId.(string))
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return ApprovalActionRead(d, m)
+	return ApprovalActionRead(ctx, d, m)
 }
 
-func ApprovalActionRead(d *schema.ResourceData, m any) error {
+func ApprovalActionRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalActionId := d.Id()
 
-	approvalAction, err := nullOps.GetApprovalAction(approvalActionId)
+	approvalAction, err := nullOps.GetApprovalAction(ctx, approvalActionId)
 	if err != nil {
-		if approvalAction.Status == "deleted" {
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", approvalAction.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("entity", approvalAction.Entity); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("action", approvalAction.Action); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", approvalAction.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, approvalAction.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("on_policy_success", approvalAction.OnPolicySuccess); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("on_policy_fail", approvalAction.OnPolicyFail); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	policyIds := make([]string, len(approvalAction.Policies))
@@ -163,13 +157,13 @@ func ApprovalActionRead(d *schema.ResourceData, m any) error {
 	}
 
 	if err := d.Set("policies", policyIds); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func ApprovalActionUpdate(d *schema.ResourceData, m any) error {
+func ApprovalActionUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalActionId := d.Id()
 
@@ -187,15 +181,8 @@ func ApprovalActionUpdate(d *schema.ResourceData, m any) error {
 		approvalAction.Entity = d.Get("action").(string)
 	}
 
-	if d.HasChange("dimensions") {
-		dimensionsMap := d.Get("dimensions").(map[string]interface{})
-
-		dimensions := make(map[string]string)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-
-		approvalAction.Dimensions = dimensions
+	if d.HasChanges("dimensions", "dimensions_all") {
+		approvalAction.Dimensions = dimensionsWithDefaults(d, m)
 	}
 
 	if d.HasChange("on_policy_success") {
@@ -207,9 +194,9 @@ func ApprovalActionUpdate(d *schema.ResourceData, m any) error {
 	}
 
 	if !reflect.DeepEqual(*approvalAction, Scope{}) {
-		err := nullOps.PatchApprovalAction(approvalActionId, approvalAction)
+		err := nullOps.PatchApprovalAction(ctx, approvalActionId, approvalAction)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
@@ -232,31 +219,31 @@ func ApprovalActionUpdate(d *schema.ResourceData, m any) error {
 
 		// Remove policies
 		for _, policyId := range oldSet.Difference(newSet).List() {
-			err := nullOps.DisassociatePolicyFromAction(approvalActionId, policyId.(string))
+			err := nullOps.DisassociatePolicyFromAction(ctx, approvalActionId, policyId.(string))
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 
 		// Add new policies
 		for _, policyId := range newSet.Difference(oldSet).List() {
-			err := nullOps.AssociatePolicyWithAction(approvalActionId, policyId.(string))
+			err := nullOps.AssociatePolicyWithAction(ctx, approvalActionId, policyId.(string))
 			if err != nil {
-				return err
+				return diagFromErr(err)
 			}
 		}
 	}
 
-	return ApprovalActionRead(d, m)
+	return ApprovalActionRead(ctx, d, m)
 }
 
-func ApprovalActionDelete(d *schema.ResourceData, m any) error {
+func ApprovalActionDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalActionId := d.Id()
 
-	err := nullOps.DeleteApprovalAction(approvalActionId)
+	err := nullOps.DeleteApprovalAction(ctx, approvalActionId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -46,9 +46,9 @@ func CreateApprovalActionPolicyAssociation(ctx context.Context, d *schema.Resour
 	approvalActionId := d.Get("approval_action_id").(string)
 	approvalPolicyId := d.Get("approval_policy_id").(string)
 
-	err := nullOps.AssociatePolicyWithAction(approvalActionId, approvalPolicyId)
+	err := nullOps.AssociatePolicyWithAction(ctx, approvalActionId, approvalPolicyId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Set a unique ID for the resource
@@ -64,9 +64,9 @@ func ReadApprovalActionPolicyAssociation(ctx context.Context, d *schema.Resource
 	approvalPolicyId := d.Get("approval_policy_id").(string)
 
 	// Get the action to verify the association still exists
-	action, err := nullOps.GetApprovalAction(approvalActionId)
+	action, err := nullOps.GetApprovalAction(ctx, approvalActionId)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting approval action: %v", err))
+		return removeIfNotFound(d, err)
 	}
 
 	// Check if the policy is still associated
@@ -92,9 +92,9 @@ func DeleteApprovalActionPolicyAssociation(ctx context.Context, d *schema.Resour
 	approvalActionId := d.Get("approval_action_id").(string)
 	approvalPolicyId := d.Get("approval_policy_id").(string)
 
-	err := nullOps.DisassociatePolicyFromAction(approvalActionId, approvalPolicyId)
+	err := nullOps.DisassociatePolicyFromAction(ctx, approvalActionId, approvalPolicyId)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error disassociating policy from action: %v", err))
+		return diagFromErr(fmt.Errorf("error disassociating policy from action: %v", err))
 	}
 
 	d.SetId("")
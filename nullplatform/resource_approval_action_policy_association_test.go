@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -45,7 +46,7 @@ func testAccCheckApprovalActionPolicyAssociationExists(n string) resource.TestCh
 		}
 
 		// Get the action to verify the association exists
-		action, err := client.GetApprovalAction(rs.Primary.Attributes["approval_action_id"])
+		action, err := client.GetApprovalAction(context.Background(), rs.Primary.Attributes["approval_action_id"])
 		if err != nil {
 			return err
 		}
@@ -79,7 +80,7 @@ func testAccCheckApprovalActionPolicyAssociationDestroy(s *terraform.State) erro
 		}
 
 		// Get the action to verify the association is gone
-		action, err := client.GetApprovalAction(rs.Primary.Attributes["approval_action_id"])
+		action, err := client.GetApprovalAction(context.Background(), rs.Primary.Attributes["approval_action_id"])
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -57,7 +58,7 @@ func testAccCheckApprovalActionExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundApprovalAction, err := client.GetApprovalAction(rs.Primary.ID)
+		foundApprovalAction, err := client.GetApprovalAction(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -81,7 +82,7 @@ func testAccCheckApprovalActionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetApprovalAction(rs.Primary.ID)
+		_, err := client.GetApprovalAction(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Approval action with ID %s still exists", rs.Primary.ID)
 		}
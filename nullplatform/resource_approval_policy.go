@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,10 +15,10 @@ func resourceApprovalPolicy() *schema.Resource {
 	return &schema.Resource{
 		Description: "The approval policy resource allows you to configure a nullplatform policy for the approval workflow",
 
-		Create: ApprovalPolicyCreate,
-		Read:   ApprovalPolicyRead,
-		Update: ApprovalPolicyUpdate,
-		Delete: ApprovalPolicyDelete,
+		CreateContext: ApprovalPolicyCreate,
+		ReadContext:   ApprovalPolicyRead,
+		UpdateContext: ApprovalPolicyUpdate,
+		DeleteContext: ApprovalPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -47,7 +48,7 @@ func resourceApprovalPolicy() *schema.Resource {
 	}
 }
 
-func ApprovalPolicyCreate(d *schema.ResourceData, m any) error {
+func ApprovalPolicyCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -55,9 +56,9 @@ func ApprovalPolicyCreate(d *schema.ResourceData, m any) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 	name := d.Get("name").(string)
@@ -66,12 +67,12 @@ func ApprovalPolicyCreate(d *schema.ResourceData, m any) error {
 
 	var conditions interface{}
 	if err := json.Unmarshal([]byte(conditionsJSON), &conditions); err != nil {
-		return fmt.Errorf("error parsing conditions JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error parsing conditions JSON: %v", err))
 	}
 
 	var selector interface{}
 	if err := json.Unmarshal([]byte(selectorJSON), &selector); err != nil {
-		return fmt.Errorf("error parsing selector JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error parsing selector JSON: %v", err))
 	}
 
 	newApprovalPolicy := &ApprovalPolicy{
@@ -81,44 +82,40 @@ func ApprovalPolicyCreate(d *schema.ResourceData, m any) error {
 		Selector:   selector,
 	}
 
-	approvalPolicy, err := nullOps.CreateApprovalPolicy(newApprovalPolicy)
+	approvalPolicy, err := nullOps.CreateApprovalPolicy(ctx, newApprovalPolicy)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(approvalPolicy.Id))
 
-	return ApprovalPolicyRead(d, m)
+	return ApprovalPolicyRead(ctx, d, m)
 }
 
-func ApprovalPolicyRead(d *schema.ResourceData, m any) error {
+func ApprovalPolicyRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalPolicyId := d.Id()
 
-	approvalPolicy, err := nullOps.GetApprovalPolicy(approvalPolicyId)
+	approvalPolicy, err := nullOps.GetApprovalPolicy(ctx, approvalPolicyId)
 	if err != nil {
-		if approvalPolicy.Status == "deleted" {
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 	if err := d.Set("nrn", approvalPolicy.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("name", approvalPolicy.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	conditionsJSON, err := json.Marshal(approvalPolicy.Conditions)
 	if err != nil {
-		return fmt.Errorf("error serializing conditions to JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error serializing conditions to JSON: %v", err))
 	}
 
 	if err := d.Set("conditions", string(conditionsJSON)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	var selectorJSON []byte
@@ -127,18 +124,18 @@ func ApprovalPolicyRead(d *schema.ResourceData, m any) error {
 	} else {
 		selectorJSON, err = json.Marshal(approvalPolicy.Selector)
 		if err != nil {
-			return fmt.Errorf("error serializing selector to JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error serializing selector to JSON: %v", err))
 		}
 	}
 
 	if err := d.Set("selector", string(selectorJSON)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func ApprovalPolicyUpdate(d *schema.ResourceData, m any) error {
+func ApprovalPolicyUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalPolicyId := d.Id()
 
@@ -156,7 +153,7 @@ func ApprovalPolicyUpdate(d *schema.ResourceData, m any) error {
 		conditionsJSON := d.Get("conditions").(string)
 		var conditions interface{}
 		if err := json.Unmarshal([]byte(conditionsJSON), &conditions); err != nil {
-			return fmt.Errorf("error parsing conditions JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error parsing conditions JSON: %v", err))
 		}
 		approvalPolicy.Conditions = conditions
 	}
@@ -165,28 +162,28 @@ func ApprovalPolicyUpdate(d *schema.ResourceData, m any) error {
 		selectorJSON := d.Get("selector").(string)
 		var selector interface{}
 		if err := json.Unmarshal([]byte(selectorJSON), &selector); err != nil {
-			return fmt.Errorf("error parsing selector JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error parsing selector JSON: %v", err))
 		}
 		approvalPolicy.Selector = selector
 	}
 
 	if !reflect.DeepEqual(*approvalPolicy, ApprovalPolicy{}) {
-		err := nullOps.PatchApprovalPolicy(approvalPolicyId, approvalPolicy)
+		err := nullOps.PatchApprovalPolicy(ctx, approvalPolicyId, approvalPolicy)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return ApprovalPolicyRead(d, m)
+	return ApprovalPolicyRead(ctx, d, m)
 }
 
-func ApprovalPolicyDelete(d *schema.ResourceData, m any) error {
+func ApprovalPolicyDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	approvalPolicyId := d.Id()
 
-	err := nullOps.DeleteApprovalPolicy(approvalPolicyId)
+	err := nullOps.DeleteApprovalPolicy(ctx, approvalPolicyId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
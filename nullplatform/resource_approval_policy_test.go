@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -49,7 +50,7 @@ func testAccCheckApprovalPolicyExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundApprovalPolicy, err := client.GetApprovalPolicy(rs.Primary.ID)
+		foundApprovalPolicy, err := client.GetApprovalPolicy(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -73,7 +74,7 @@ func testAccCheckApprovalPolicyDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetApprovalPolicy(rs.Primary.ID)
+		_, err := client.GetApprovalPolicy(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Approval policy with ID %s still exists", rs.Primary.ID)
 		}
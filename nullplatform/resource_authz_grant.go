@@ -41,7 +41,7 @@ func resourceAuthzGrant() *schema.Resource {
 	}
 }
 
-func CreateAuthzGrant(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func CreateAuthzGrant(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -49,9 +49,9 @@ func CreateAuthzGrant(_ context.Context, d *schema.ResourceData, m interface{})
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
@@ -61,42 +61,42 @@ func CreateAuthzGrant(_ context.Context, d *schema.ResourceData, m interface{})
 		NRN:      nrn,
 	}
 
-	newGrant, err := nullOps.CreateAuthzGrant(grant)
+	newGrant, err := nullOps.CreateAuthzGrant(ctx, grant)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(newGrant.ID))
-	return ReadAuthzGrant(context.Background(), d, m)
+	return ReadAuthzGrant(ctx, d, m)
 }
 
-func ReadAuthzGrant(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ReadAuthzGrant(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	grant, err := nullOps.GetAuthzGrant(d.Id())
+	grant, err := nullOps.GetAuthzGrant(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("user_id", grant.UserID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("role_slug", grant.RoleSlug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("nrn", grant.NRN); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func DeleteAuthzGrant(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func DeleteAuthzGrant(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	err := nullOps.DeleteAuthzGrant(d.Id())
+	err := nullOps.DeleteAuthzGrant(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -80,7 +80,7 @@ func CapabilityCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 	definitionStr := d.Get("definition").(string)
 	var definition map[string]interface{}
 	if err := json.Unmarshal([]byte(definitionStr), &definition); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing definition JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing definition JSON: %v", err))
 	}
 
 	newCapability := &CapabilityEntity{
@@ -92,9 +92,9 @@ func CapabilityCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 		Status:      d.Get("status").(string),
 	}
 
-	capability, err := nullOps.CreateCapability(newCapability)
+	capability, err := nullOps.CreateCapability(ctx, newCapability)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(capability.Id))
@@ -105,40 +105,40 @@ func CapabilityRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	nullOps := m.(NullOps)
 	capabilityId := d.Id()
 
-	capability, err := nullOps.GetCapability(capabilityId)
+	capability, err := nullOps.GetCapability(ctx, capabilityId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", capability.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", capability.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", capability.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("target", capability.Target); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", capability.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("created_at", capability.CreatedAt); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("updated_at", capability.UpdatedAt); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if capability.Definition != nil {
 		definitionJSON, err := json.Marshal(capability.Definition)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing definition to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing definition to JSON: %v", err))
 		}
 		if err := d.Set("definition", string(definitionJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -167,13 +167,13 @@ func CapabilityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 		definitionStr := d.Get("definition").(string)
 		var definition map[string]interface{}
 		if err := json.Unmarshal([]byte(definitionStr), &definition); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing definition JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing definition JSON: %v", err))
 		}
 		capability.Definition = definition
 	}
 
-	if err := nullOps.PatchCapability(capabilityId, capability); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.PatchCapability(ctx, capabilityId, capability); err != nil {
+		return diagFromErr(err)
 	}
 
 	return CapabilityRead(ctx, d, m)
@@ -183,8 +183,8 @@ func CapabilityDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 	nullOps := m.(NullOps)
 	capabilityId := d.Id()
 
-	if err := nullOps.DeleteCapability(capabilityId); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteCapability(ctx, capabilityId); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -98,22 +98,22 @@ func DeploymentStrategyCreate(ctx context.Context, d *schema.ResourceData, m int
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
 	dimensionsStr := d.Get("dimensions").(string)
 	var dimensions map[string]interface{}
 	if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 	}
 
 	parametersStr := d.Get("parameters").(string)
 	var parameters map[string]interface{}
 	if err := json.Unmarshal([]byte(parametersStr), &parameters); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
 	}
 
 	newDS := &DeploymentStrategy{
@@ -125,9 +125,9 @@ func DeploymentStrategyCreate(ctx context.Context, d *schema.ResourceData, m int
 		ScopeTypeIds: deploymentStrategyScopeTypeIds(d),
 	}
 
-	ds, err := nullOps.CreateDeploymentStrategy(newDS)
+	ds, err := nullOps.CreateDeploymentStrategy(ctx, newDS)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(ds.Id))
@@ -138,50 +138,50 @@ func DeploymentStrategyRead(ctx context.Context, d *schema.ResourceData, m inter
 	nullOps := m.(NullOps)
 	dsId := d.Id()
 
-	ds, err := nullOps.GetDeploymentStrategy(dsId)
+	ds, err := nullOps.GetDeploymentStrategy(ctx, dsId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", ds.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", ds.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("nrn", ds.Nrn); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("scope_type_ids", ds.ScopeTypeIds); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("created_by", ds.CreatedBy); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("updated_by", ds.UpdatedBy); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("created_at", ds.CreatedAt); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("updated_at", ds.UpdatedAt); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	dimensionsJSON, err := json.Marshal(ds.Dimensions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
 	}
 	if err := d.Set("dimensions", string(dimensionsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	parametersJSON, err := json.Marshal(ds.Parameters)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
 	}
 	if err := d.Set("parameters", string(parametersJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -203,7 +203,7 @@ func DeploymentStrategyUpdate(ctx context.Context, d *schema.ResourceData, m int
 		dimensionsStr := d.Get("dimensions").(string)
 		var dimensions map[string]interface{}
 		if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 		}
 		ds.Dimensions = dimensions
 	}
@@ -211,7 +211,7 @@ func DeploymentStrategyUpdate(ctx context.Context, d *schema.ResourceData, m int
 		parametersStr := d.Get("parameters").(string)
 		var parameters map[string]interface{}
 		if err := json.Unmarshal([]byte(parametersStr), &parameters); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
 		}
 		ds.Parameters = parameters
 	}
@@ -219,8 +219,8 @@ func DeploymentStrategyUpdate(ctx context.Context, d *schema.ResourceData, m int
 		ds.ScopeTypeIds = deploymentStrategyScopeTypeIds(d)
 	}
 
-	if err := nullOps.PatchDeploymentStrategy(dsId, ds); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.PatchDeploymentStrategy(ctx, dsId, ds); err != nil {
+		return diagFromErr(err)
 	}
 
 	return DeploymentStrategyRead(ctx, d, m)
@@ -230,8 +230,8 @@ func DeploymentStrategyDelete(ctx context.Context, d *schema.ResourceData, m int
 	nullOps := m.(NullOps)
 	dsId := d.Id()
 
-	if err := nullOps.DeleteDeploymentStrategy(dsId); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteDeploymentStrategy(ctx, dsId); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +13,10 @@ func resourceDimension() *schema.Resource {
 	return &schema.Resource{
 		Description: "The dimension resource allows you to configure a Nullplatform Dimension",
 
-		Create: DimensionCreate,
-		Read:   DimensionRead,
-		Update: DimensionUpdate,
-		Delete: DimensionDelete,
+		CreateContext: DimensionCreate,
+		ReadContext:   DimensionRead,
+		UpdateContext: DimensionUpdate,
+		DeleteContext: DimensionDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -49,7 +50,7 @@ func resourceDimension() *schema.Resource {
 	}
 }
 
-func DimensionCreate(d *schema.ResourceData, m interface{}) error {
+func DimensionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -57,9 +58,9 @@ func DimensionCreate(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
@@ -69,44 +70,44 @@ func DimensionCreate(d *schema.ResourceData, m interface{}) error {
 		Order: d.Get("order").(int),
 	}
 
-	createdDimension, err := nullOps.CreateDimension(dimension)
+	createdDimension, err := nullOps.CreateDimension(ctx, dimension)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(createdDimension.ID))
-	return DimensionRead(d, m)
+	return DimensionRead(ctx, d, m)
 }
 
-func DimensionRead(d *schema.ResourceData, m interface{}) error {
+func DimensionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	dimensionID := d.Id()
 
-	dimension, err := nullOps.GetDimension(&dimensionID, nil, nil, nil, nil)
+	dimension, err := nullOps.GetDimension(ctx, &dimensionID, nil, nil, nil, nil)
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", dimension.NRN); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("name", dimension.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", dimension.Slug); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", dimension.Status); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("order", dimension.Order); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func DimensionUpdate(d *schema.ResourceData, m interface{}) error {
+func DimensionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	dimensionID := d.Id()
 
@@ -119,21 +120,21 @@ func DimensionUpdate(d *schema.ResourceData, m interface{}) error {
 		dimension.Order = d.Get("order").(int)
 	}
 
-	err := nullOps.UpdateDimension(dimensionID, dimension)
+	err := nullOps.UpdateDimension(ctx, dimensionID, dimension)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return DimensionRead(d, m)
+	return DimensionRead(ctx, d, m)
 }
 
-func DimensionDelete(d *schema.ResourceData, m interface{}) error {
+func DimensionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	dimensionID := d.Id()
 
-	err := nullOps.DeleteDimension(dimensionID)
+	err := nullOps.DeleteDimension(ctx, dimensionID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -59,9 +59,9 @@ func resourceDimensionValueCreate(ctx context.Context, d *schema.ResourceData, m
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, c)
+		nrn, err = ConstructNRNFromComponents(ctx, d, c)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
@@ -71,9 +71,9 @@ func resourceDimensionValueCreate(ctx context.Context, d *schema.ResourceData, m
 		NRN:         nrn,
 	}
 
-	createdValue, err := c.CreateDimensionValue(dimensionValue)
+	createdValue, err := c.CreateDimensionValue(ctx, dimensionValue)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(createdValue.ID))
@@ -86,14 +86,14 @@ func resourceDimensionValueRead(ctx context.Context, d *schema.ResourceData, m i
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("invalid dimension value ID: %v", err))
+		return diagFromErr(fmt.Errorf("invalid dimension value ID: %v", err))
 	}
 
 	dimensionID := d.Get("dimension_id").(int)
 
-	value, err := c.GetDimensionValue(dimensionID, id)
+	value, err := c.GetDimensionValue(ctx, dimensionID, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	d.Set("dimension_id", value.DimensionID)
@@ -110,14 +110,14 @@ func resourceDimensionValueDelete(ctx context.Context, d *schema.ResourceData, m
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("invalid dimension value ID: %v", err))
+		return diagFromErr(fmt.Errorf("invalid dimension value ID: %v", err))
 	}
 
 	dimensionID := d.Get("dimension_id").(int)
 
-	err = c.DeleteDimensionValue(dimensionID, id)
+	err = c.DeleteDimensionValue(ctx, dimensionID, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
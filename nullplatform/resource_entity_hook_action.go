@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +13,11 @@ func resourceEntityHookAction() *schema.Resource {
 	return &schema.Resource{
 		Description: "The entity hook action resource allows you to configure a nullplatform action for the entity hook workflow",
 
-		Create: EntityHookActionCreate,
-		Read:   EntityHookActionRead,
-		Update: EntityHookActionUpdate,
-		Delete: EntityHookActionDelete,
+		CreateContext: EntityHookActionCreate,
+		ReadContext:   EntityHookActionRead,
+		UpdateContext: EntityHookActionUpdate,
+		DeleteContext: EntityHookActionDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -45,6 +47,7 @@ func resourceEntityHookAction() *schema.Resource {
 				},
 				Description: "Key-value pairs defining the scope of the action. Defaults to empty map. Example: `{\"environment\":\"production\",\"country\":\"us\"}`.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 			"on_policy_success": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -76,7 +79,7 @@ func resourceEntityHookAction() *schema.Resource {
 	}
 }
 
-func EntityHookActionCreate(d *schema.ResourceData, m any) error {
+func EntityHookActionCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -84,9 +87,9 @@ func EntityHookActionCreate(d *schema.ResourceData, m any) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 	entity := d.Get("entity").(string)
@@ -97,14 +100,7 @@ func EntityHookActionCreate(d *schema.ResourceData, m any) error {
 	hookType := d.Get("type").(string)
 	on := d.Get("on").(string)
 
-	// Get dimensions or use empty map as default
-	dimensions := make(map[string]string)
-	if v, ok := d.GetOk("dimensions"); ok {
-		dimensionsMap := v.(map[string]any)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	newEntityHookAction := &EntityHookAction{
 		Nrn:             nrn,
@@ -118,69 +114,65 @@ func EntityHookActionCreate(d *schema.ResourceData, m any) error {
 		On:              on,
 	}
 
-	entityHookAction, err := nullOps.CreateEntityHookAction(newEntityHookAction)
+	entityHookAction, err := nullOps.CreateEntityHookAction(ctx, newEntityHookAction)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(entityHookAction.Id)
 
-	return EntityHookActionRead(d, m)
+	return EntityHookActionRead(ctx, d, m)
 }
 
-func EntityHookActionRead(d *schema.ResourceData, m any) error {
+func EntityHookActionRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	entityHookActionId := d.Id()
 
-	entityHookAction, err := nullOps.GetEntityHookAction(entityHookActionId)
+	entityHookAction, err := nullOps.GetEntityHookAction(ctx, entityHookActionId)
 	if err != nil {
-		if entityHookAction != nil && entityHookAction.Status == "deleted" {
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", entityHookAction.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("entity", entityHookAction.Entity); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("action", entityHookAction.Action); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", entityHookAction.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, entityHookAction.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("on_policy_success", entityHookAction.OnPolicySuccess); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("on_policy_fail", entityHookAction.OnPolicyFail); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("when", entityHookAction.When); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("type", entityHookAction.Type); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("on", entityHookAction.On); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func EntityHookActionUpdate(d *schema.ResourceData, m any) error {
+func EntityHookActionUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	entityHookActionId := d.Id()
 
@@ -202,15 +194,8 @@ func EntityHookActionUpdate(d *schema.ResourceData, m any) error {
 		hasChanges = true
 	}
 
-	if d.HasChange("dimensions") {
-		dimensionsMap := d.Get("dimensions").(map[string]interface{})
-
-		dimensions := make(map[string]string)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-
-		entityHookAction.Dimensions = dimensions
+	if d.HasChanges("dimensions", "dimensions_all") {
+		entityHookAction.Dimensions = dimensionsWithDefaults(d, m)
 		hasChanges = true
 	}
 
@@ -240,22 +225,22 @@ func EntityHookActionUpdate(d *schema.ResourceData, m any) error {
 	}
 
 	if hasChanges && !reflect.DeepEqual(*entityHookAction, EntityHookAction{}) {
-		err := nullOps.PatchEntityHookAction(entityHookActionId, entityHookAction)
+		err := nullOps.PatchEntityHookAction(ctx, entityHookActionId, entityHookAction)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return EntityHookActionRead(d, m)
+	return EntityHookActionRead(ctx, d, m)
 }
 
-func EntityHookActionDelete(d *schema.ResourceData, m any) error {
+func EntityHookActionDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	entityHookActionId := d.Id()
 
-	err := nullOps.DeleteEntityHookAction(entityHookActionId)
+	err := nullOps.DeleteEntityHookAction(ctx, entityHookActionId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -2,8 +2,14 @@ package nullplatform
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
 	"reflect"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,10 +17,11 @@ func resourceLink() *schema.Resource {
 	return &schema.Resource{
 		Description: "The link resource allows you to configure a Nullplatform Link",
 
-		Create: LinkCreate,
-		Read:   LinkRead,
-		Update: LinkUpdate,
-		Delete: LinkDelete,
+		CreateContext: LinkCreate,
+		ReadContext:   LinkRead,
+		UpdateContext: LinkUpdate,
+		DeleteContext: LinkDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("entity_nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -23,6 +30,12 @@ func resourceLink() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -46,15 +59,17 @@ func resourceLink() *schema.Resource {
 				Description: "Unique identifier for the entity represented as a UUID.",
 			},
 			"entity_nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "NRN representing a hierarchical identifier for nullplatform resources. Value must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "NRN representing a hierarchical identifier for nullplatform resources. Value must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"linkable_to": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
 				},
 				Description: "A list of NRN representing the visibility settings for the entity. Specifies what/who can see this entity. Value must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
 			},
@@ -79,6 +94,7 @@ func resourceLink() *schema.Resource {
 				},
 				Description: "Object representing dimensions with key-value pairs.",
 			},
+			"dimensions_all": dimensionsAllSchema(false),
 			"selectors": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -90,13 +106,24 @@ func resourceLink() *schema.Resource {
 			"status": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "Status of the link. Should be one of: [`pending_create`, `pending`, `creating`, `updating`, `deleting`, `active`, `deleted`, `failed`]",
 			},
+			"import": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+				Description: "When true (default), provisioning and decommissioning of the link's underlying " +
+					"infrastructure are handled externally to nullplatform. When false, the link specification's " +
+					"create, update and delete actions are triggered to handle it; a failed update action " +
+					"restores the previous attributes.",
+			},
 		},
 	}
 }
 
-func LinkCreate(d *schema.ResourceData, m any) error {
+func LinkCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	name := d.Get("name").(string)
@@ -105,8 +132,13 @@ func LinkCreate(d *schema.ResourceData, m any) error {
 	entityNrn := d.Get("entity_nrn").(string)
 	linkableTo := d.Get("linkable_to").([]interface{})
 	status := d.Get("status").(string)
+	if !importMode(d) {
+		// Like services, an action-driven link must start pending so its
+		// create action can transition it to active.
+		status = "pending"
+	}
 	attributes := d.Get("attributes").(map[string]interface{})
-	dimensions := d.Get("dimensions").(map[string]interface{})
+	dimensions := mapOfStringsToMapOfInterfaces(dimensionsWithDefaults(d, m))
 	selectors := d.Get("selectors").(map[string]interface{})
 
 	newLink := &Link{
@@ -121,76 +153,82 @@ func LinkCreate(d *schema.ResourceData, m any) error {
 		Dimensions:      dimensions,
 	}
 
-	l, err := nullOps.CreateLink(newLink)
+	l, adoptedAfter, err := nullOps.CreateLink(ctx, newLink)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(l.Id)
+	diags := adoptionWarning("link", adoptedAfter)
 
-	return nil
+	if !importMode(d) {
+		if err := triggerLinkAction(ctx, nullOps, l.Id, specificationId, "create", attributes, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return append(diags, diagFromErr(err)...)
+		}
+	}
+
+	return diags
 }
 
-func LinkRead(d *schema.ResourceData, m any) error {
+func LinkRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	linkId := d.Id()
 
-	l, err := nullOps.GetLink(linkId)
+	l, err := nullOps.GetLink(ctx, linkId)
 
 	if err != nil {
-		d.SetId("")
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", l.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("slug", l.Slug); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("service_id", l.ServiceId); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("specification_id", l.SpecificationId); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("desired_specification_id", l.DesiredSpecificationId); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("entity_nrn", l.EntityNrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("linkable_to", l.LinkableTo); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("status", l.Status); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", l.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, mapOfInterfacesToMapOfStrings(l.Dimensions)); err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("selectors", l.Selectors); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("attributes", l.Attributes); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func LinkUpdate(d *schema.ResourceData, m any) error {
+func LinkUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	linkId := d.Id()
@@ -229,10 +267,31 @@ func LinkUpdate(d *schema.ResourceData, m any) error {
 		l.LinkableTo = d.Get("linkable_to").([]interface{})
 	}
 
-	if d.HasChange("dimensions") {
-		dimensions := d.Get("dimensions").(map[string]interface{})
+	if d.HasChanges("dimensions", "dimensions_all") {
+		l.Dimensions = mapOfStringsToMapOfInterfaces(dimensionsWithDefaults(d, m))
+	}
+
+	var diags diag.Diagnostics
 
-		l.Dimensions = dimensions
+	// As for services, attribute changes of an action-driven link run the
+	// link specification's update action.
+	var updateSpec *ActionSpecification
+	if d.HasChange("attributes") && !importMode(d) {
+		specificationID := d.Get("specification_id").(string)
+		specs, err := nullOps.ListLinkActionSpecifications(ctx, specificationID)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("listing link action specifications: %w", err))
+		}
+		updateSpec, err = findActionSpecByType(specs, "update")
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "No Update Action",
+				Detail: fmt.Sprintf("Link specification %s has no update action, so the new attributes are only "+
+					"saved on the link and its infrastructure is not changed.", specificationID),
+				AttributePath: cty.GetAttrPath("attributes"),
+			})
+		}
 	}
 
 	if d.HasChange("attributes") {
@@ -248,26 +307,74 @@ func LinkUpdate(d *schema.ResourceData, m any) error {
 	}
 
 	if !reflect.DeepEqual(*l, Link{}) {
-		err := nullOps.PatchLink(linkId, l)
+		err := nullOps.PatchLink(ctx, linkId, l)
 		if err != nil {
-			return err
+			return append(diags, diagFromErr(err)...)
 		}
 	}
 
-	return nil
+	if updateSpec != nil {
+		attrs := d.Get("attributes").(map[string]interface{})
+		if _, err := linkActions(nullOps, linkId).run(ctx, updateSpec, attrs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			d.Partial(true)
+			var orphanedErr *OrphanedActionError
+			if errors.As(err, &orphanedErr) {
+				// Interrupted: there is no context left to restore with,
+				// and the next apply runs the update again.
+				return append(diags, diagFromErr(err)...)
+			}
+			oldAttrs, _ := d.GetChange("attributes")
+			return append(diags, recoverFailedUpdate(ctx, "link", linkId, err,
+				func() error {
+					return nullOps.PatchLink(ctx, linkId, &Link{Attributes: oldAttrs.(map[string]interface{})})
+				},
+				func() error {
+					return nullOps.PatchLink(ctx, linkId, &Link{Status: "failed"})
+				},
+			)...)
+		}
+	}
+
+	return diags
 }
 
-func LinkDelete(d *schema.ResourceData, m any) error {
+func LinkDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	linkId := d.Id()
 
-	err := nullOps.DeleteLink(linkId)
+	if !importMode(d) {
+		// As with services, a link whose create action failed cannot be torn
+		// down by its delete action, so it is deleted directly instead.
+		current, err := nullOps.GetLink(ctx, linkId)
+		if err == nil && current != nil && current.Status == "failed" {
+			log.Printf("[INFO] link %s is in status=failed; deleting it instead of triggering delete action", linkId)
+		} else {
+			specificationID := d.Get("specification_id").(string)
+			attrs, _ := d.Get("attributes").(map[string]interface{})
+			if err := triggerLinkAction(ctx, nullOps, linkId, specificationID, "delete", attrs, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return diagFromErr(err)
+			}
+			d.SetId("")
+			return nil
+		}
+	}
+
+	err := nullOps.DeleteLink(ctx, linkId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+func triggerLinkAction(ctx context.Context, nullOps NullOps, linkID, specificationID, actionType string, attributes map[string]interface{}, timeout time.Duration) error {
+	specs, err := nullOps.ListLinkActionSpecifications(ctx, specificationID)
+	if err != nil {
+		return fmt.Errorf("listing link action specifications: %w", err)
+	}
+	_, _, err = linkActions(nullOps, linkID).trigger(ctx, specs, specificationID, actionType, attributes, timeout)
+	return err
+}
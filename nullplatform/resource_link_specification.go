@@ -67,8 +67,9 @@ func resourceLinkSpecification() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type:     schema.TypeString,
-					MinItems: 1,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
+					MinItems:         1,
 				},
 				Description: "Array representing visibility settings for the link specification",
 			},
@@ -164,7 +165,7 @@ func resourceLinkSpecification() *schema.Resource {
 	}
 }
 
-func CreateLinkSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func CreateLinkSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var visibleTo []string
@@ -179,19 +180,19 @@ func CreateLinkSpecification(_ context.Context, d *schema.ResourceData, m interf
 	dimensionsStr := d.Get("dimensions").(string)
 	var dimensions map[string]interface{}
 	if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 	}
 
 	attributesStr := d.Get("attributes").(string)
 	var attributes map[string]interface{}
 	if err := json.Unmarshal([]byte(attributesStr), &attributes); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 	}
 
 	scopesStr := d.Get("scopes").(string)
 	var scopes map[string]interface{}
 	if err := json.Unmarshal([]byte(scopesStr), &scopes); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
 	}
 
 	selectorsList := d.Get("selectors").([]interface{})
@@ -223,111 +224,114 @@ func CreateLinkSpecification(_ context.Context, d *schema.ResourceData, m interf
 	if externalStr, ok := d.GetOk("external"); ok {
 		var external map[string]interface{}
 		if err := json.Unmarshal([]byte(externalStr.(string)), &external); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing external JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing external JSON: %v", err))
 		}
 		spec.External = external
 	}
 
-	newSpec, err := nullOps.CreateLinkSpecification(spec)
+	newSpec, err := nullOps.CreateLinkSpecification(ctx, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newSpec.Id)
-	return ReadLinkSpecification(context.Background(), d, m)
+	return ReadLinkSpecification(ctx, d, m)
 }
 
-func ReadLinkSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ReadLinkSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
+	var diags diag.Diagnostics
 	specId := d.Id()
 
-	spec, err := nullOps.GetLinkSpecification(specId)
+	spec, err := nullOps.GetLinkSpecification(ctx, specId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("unique", spec.Unique); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("specification_id", spec.SpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", spec.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if spec.UseDefaultActions != nil {
 		if err := d.Set("use_default_actions", *spec.UseDefaultActions); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if spec.UseDefaultNaming != nil {
 		if err := d.Set("use_default_naming", *spec.UseDefaultNaming); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	// Best-effort snapshot + default-action exposure for package pinning.
-	if snapshotID, snapErr := nullOps.GetLatestSnapshotID("link_specification", specId); snapErr == nil {
+	if snapshotID, snapErr := nullOps.GetLatestSnapshotID(ctx, "link_specification", specId); snapErr == nil {
 		if err := d.Set("last_snapshot_id", snapshotID); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
-	if actions, actErr := nullOps.ListLinkActionSpecifications(specId); actErr == nil {
-		if err := d.Set("action_specifications", actionSpecsToComputedList(nullOps, actions)); err != nil {
-			return diag.FromErr(err)
+	if actions, actErr := nullOps.ListLinkActionSpecifications(ctx, specId); actErr == nil {
+		actionSpecs, actionDiags := actionSpecsToComputedList(ctx, nullOps, actions)
+		diags = append(diags, actionDiags...)
+		if err := d.Set("action_specifications", actionSpecs); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	dimensionsJSON, err := json.Marshal(spec.Dimensions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
 	}
 	if err := d.Set("dimensions", string(dimensionsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("assignable_to", spec.AssignableTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	attributesJSON, err := json.Marshal(spec.Attributes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
 	}
 	if err := d.Set("attributes", string(attributesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	scopesJSON, err := json.Marshal(spec.Scopes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
 	}
 	if err := d.Set("scopes", string(scopesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if spec.External != nil {
 		externalJSON, err := json.Marshal(spec.External)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing external to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing external to JSON: %v", err))
 		}
 		if err := d.Set("external", string(externalJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if spec.ExternalResolution != nil {
 		externalResolutionJSON, err := json.Marshal(spec.ExternalResolution)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing external_resolution to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing external_resolution to JSON: %v", err))
 		}
 		if err := d.Set("external_resolution", string(externalResolutionJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -340,10 +344,10 @@ func ReadLinkSpecification(_ context.Context, d *schema.ResourceData, m interfac
 		},
 	}
 	if err := d.Set("selectors", selectors); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -381,7 +385,7 @@ func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m inte
 		dimensionsStr := d.Get("dimensions").(string)
 		var dimensions map[string]interface{}
 		if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 		}
 		spec.Dimensions = dimensions
 	}
@@ -394,7 +398,7 @@ func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m inte
 		attributesStr := d.Get("attributes").(string)
 		var attributes map[string]interface{}
 		if err := json.Unmarshal([]byte(attributesStr), &attributes); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 		}
 		spec.Attributes = attributes
 	}
@@ -403,7 +407,7 @@ func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m inte
 		scopesStr := d.Get("scopes").(string)
 		var scopes map[string]interface{}
 		if err := json.Unmarshal([]byte(scopesStr), &scopes); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
 		}
 		spec.Scopes = scopes
 	}
@@ -425,7 +429,7 @@ func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m inte
 		if externalStr, ok := d.GetOk("external"); ok {
 			var external map[string]interface{}
 			if err := json.Unmarshal([]byte(externalStr.(string)), &external); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing external JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing external JSON: %v", err))
 			}
 			spec.External = external
 		} else {
@@ -433,21 +437,21 @@ func UpdateLinkSpecification(ctx context.Context, d *schema.ResourceData, m inte
 		}
 	}
 
-	err := nullOps.PatchLinkSpecification(specId, spec)
+	err := nullOps.PatchLinkSpecification(ctx, specId, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return ReadLinkSpecification(ctx, d, m)
 }
 
-func DeleteLinkSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func DeleteLinkSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	specId := d.Id()
 
-	err := nullOps.DeleteLinkSpecification(specId)
+	err := nullOps.DeleteLinkSpecification(ctx, specId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
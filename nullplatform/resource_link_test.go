@@ -0,0 +1,269 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// linkActionsTestServer fakes a link whose specification has create, update
+// and delete actions with an integer "size" parameter.
+type linkActionsTestServer struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	actionStatus  string
+	linkStatus    string
+	created       []Link
+	patches       []map[string]interface{}
+	deleted       int
+	actionsPosted []ActionInstance
+}
+
+func newLinkActionsTestServer(t *testing.T, actionStatus string) *linkActionsTestServer {
+	t.Helper()
+	s := &linkActionsTestServer{actionStatus: actionStatus, linkStatus: "active"}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		parameters := map[string]interface{}{
+			"schema": map[string]interface{}{
+				"properties": map[string]interface{}{"size": map[string]interface{}{"type": "integer"}},
+			},
+		}
+
+		switch {
+		case r.URL.Path == "/link_specification/spec-1/action_specification":
+			json.NewEncoder(w).Encode(map[string]interface{}{"results": []map[string]interface{}{
+				{"id": "create-spec", "type": "create", "parameters": parameters},
+				{"id": "update-spec", "type": "update", "parameters": parameters},
+				{"id": "delete-spec", "type": "delete", "parameters": parameters},
+			}})
+		case r.URL.Path == "/link" && r.Method == http.MethodPost:
+			var body Link
+			json.NewDecoder(r.Body).Decode(&body)
+			s.created = append(s.created, body)
+			body.Id = "link-1"
+			json.NewEncoder(w).Encode(body)
+		case r.URL.Path == "/link/link-1" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(Link{Id: "link-1", Status: s.linkStatus})
+		case r.URL.Path == "/link/link-1" && r.Method == http.MethodPatch:
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			s.patches = append(s.patches, body)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/link/link-1" && r.Method == http.MethodDelete:
+			s.deleted++
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/link/link-1/action" && r.Method == http.MethodPost:
+			var body ActionInstance
+			json.NewDecoder(r.Body).Decode(&body)
+			s.actionsPosted = append(s.actionsPosted, body)
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-1", Status: "pending"})
+		case r.URL.Path == "/link/link-1/action/act-1":
+			json.NewEncoder(w).Encode(ActionInstance{
+				Id:       "act-1",
+				Status:   s.actionStatus,
+				Messages: []interface{}{map[string]interface{}{"severity": "error", "message": "quota exceeded"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+// linkState is the state of an action-driven link whose size is 1.
+func linkState() *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "link-1",
+		Attributes: map[string]string{
+			"id":               "link-1",
+			"name":             "db-access",
+			"service_id":       "svc-1",
+			"specification_id": "spec-1",
+			"entity_nrn":       "organization=1",
+			"import":           "false",
+			"status":           "active",
+			"attributes.%":     "1",
+			"attributes.size":  "1",
+		},
+	}
+}
+
+// linkData returns the data of an action-driven link planned with size,
+// created from scratch when state is nil.
+func linkData(t *testing.T, client *NullClient, state *terraform.InstanceState, size string) *schema.ResourceData {
+	t.Helper()
+	r := resourceLink()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "db-access",
+		"service_id":       "svc-1",
+		"specification_id": "spec-1",
+		"entity_nrn":       "organization=1",
+		"import":           false,
+		"attributes":       map[string]interface{}{"size": size},
+	})
+	diff, err := r.SimpleDiff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestLinkCreate_RunsCreateAction(t *testing.T) {
+	shortenActionPolling(t)
+	server := newLinkActionsTestServer(t, "success")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := linkData(t, client, nil, "1")
+	if diags := LinkCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(server.created) != 1 || server.created[0].Status != "pending" {
+		t.Errorf("created %+v, want one link created pending", server.created)
+	}
+	if len(server.actionsPosted) != 1 || server.actionsPosted[0].SpecificationId != "create-spec" {
+		t.Fatalf("posted %+v, want the create action", server.actionsPosted)
+	}
+	if got := server.actionsPosted[0].Parameters["size"]; got != float64(1) {
+		t.Errorf("size parameter = %v, want 1", got)
+	}
+}
+
+func TestLinkCreate_ReportsFailedAction(t *testing.T) {
+	shortenActionPolling(t)
+	server := newLinkActionsTestServer(t, "failed")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := linkData(t, client, nil, "1")
+	diags := LinkCreate(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary+diags[0].Detail, "quota exceeded") {
+		t.Fatalf("diags = %v, want the action's messages", diags)
+	}
+	if d.Id() != "link-1" {
+		t.Errorf("id = %q, want the created link kept in state", d.Id())
+	}
+}
+
+func TestLinkUpdate_RunsUpdateAction(t *testing.T) {
+	shortenActionPolling(t)
+	server := newLinkActionsTestServer(t, "success")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := linkData(t, client, linkState(), "2")
+	if diags := LinkUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(server.actionsPosted) != 1 || server.actionsPosted[0].SpecificationId != "update-spec" {
+		t.Fatalf("posted %+v, want the update action", server.actionsPosted)
+	}
+	if len(server.patches) != 1 || server.patches[0]["attributes"].(map[string]interface{})["size"] != "2" {
+		t.Errorf("patches = %v, want the new attributes saved once", server.patches)
+	}
+}
+
+func TestLinkUpdate_RestoresAttributesWhenActionFails(t *testing.T) {
+	shortenActionPolling(t)
+	server := newLinkActionsTestServer(t, "failed")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := linkData(t, client, linkState(), "2")
+	diags := LinkUpdate(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected the failed action to be reported")
+	}
+	if !strings.Contains(diags[0].Detail, "quota exceeded") || !strings.Contains(diags[0].Detail, "previous attributes were restored") {
+		t.Errorf("detail = %q", diags[0].Detail)
+	}
+	if len(server.patches) != 2 || server.patches[1]["attributes"].(map[string]interface{})["size"] != "1" {
+		t.Errorf("patches = %v, want the update and the restore of size 1", server.patches)
+	}
+}
+
+func TestLinkDelete(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		linkStatus  string
+		wantActions int
+		wantDeletes int
+	}{
+		{name: "runs delete action", linkStatus: "active", wantActions: 1},
+		{name: "deletes failed link", linkStatus: "failed", wantDeletes: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			shortenActionPolling(t)
+			server := newLinkActionsTestServer(t, "success")
+			server.linkStatus = tc.linkStatus
+			defer server.Close()
+			client := newTestClient(server.Server)
+
+			d, err := schema.InternalMap(resourceLink().Schema).Data(linkState(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diags := LinkDelete(context.Background(), d, client); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if len(server.actionsPosted) != tc.wantActions || server.deleted != tc.wantDeletes {
+				t.Errorf("posted %d actions and deleted %d times, want %d and %d",
+					len(server.actionsPosted), server.deleted, tc.wantActions, tc.wantDeletes)
+			}
+			if tc.wantActions > 0 && server.actionsPosted[0].SpecificationId != "delete-spec" {
+				t.Errorf("posted %+v, want the delete action", server.actionsPosted[0])
+			}
+			if d.Id() != "" {
+				t.Errorf("id = %q, want it cleared", d.Id())
+			}
+		})
+	}
+}
+
+func TestLinkPlan_KeepsStatus(t *testing.T) {
+	server := newLinkActionsTestServer(t, "success")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "db-access",
+		"service_id":       "svc-1",
+		"specification_id": "spec-1",
+		"entity_nrn":       "organization=1",
+		"import":           false,
+		"attributes":       map[string]interface{}{"size": "1"},
+	})
+	for _, status := range []string{"active", "failed"} {
+		t.Run(status, func(t *testing.T) {
+			state := linkState()
+			state.Attributes["status"] = status
+			diff, err := resourceLink().SimpleDiff(context.Background(), state, config, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff != nil {
+				if attr, ok := diff.Attributes["status"]; ok {
+					t.Errorf("status planned %q -> %q, want no change", attr.Old, attr.New)
+				}
+			}
+		})
+	}
+}
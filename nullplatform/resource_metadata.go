@@ -85,16 +85,16 @@ func MetadataCreate(ctx context.Context, d *schema.ResourceData, m interface{})
 	valueStr := d.Get("value").(string)
 	var value interface{}
 	if err := json.Unmarshal([]byte(valueStr), &value); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing metadata value JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing metadata value JSON: %v", err))
 	}
 
 	metadata := &Metadata{
 		Value: value,
 	}
 
-	err := nullOps.CreateMetadata(entity, entityId, metadataType, metadata)
+	err := nullOps.CreateMetadata(ctx, entity, entityId, metadataType, metadata)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(buildMetadataId(entity, entityId, metadataType))
@@ -106,30 +106,30 @@ func MetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) di
 
 	entity, entityId, metadataType, err := parseMetadataId(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	metadata, err := nullOps.GetMetadata(entity, entityId, metadataType)
+	metadata, err := nullOps.GetMetadata(ctx, entity, entityId, metadataType)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("entity", entity); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("entity_id", entityId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", metadataType); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	valueJSON, err := json.Marshal(metadata.Value)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing metadata value to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing metadata value to JSON: %v", err))
 	}
 	if err := d.Set("value", string(valueJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -140,23 +140,23 @@ func MetadataUpdate(ctx context.Context, d *schema.ResourceData, m interface{})
 
 	entity, entityId, metadataType, err := parseMetadataId(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("value") {
 		valueStr := d.Get("value").(string)
 		var value interface{}
 		if err := json.Unmarshal([]byte(valueStr), &value); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing metadata value JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing metadata value JSON: %v", err))
 		}
 
 		metadata := &Metadata{
 			Value: value,
 		}
 
-		err := nullOps.UpdateMetadata(entity, entityId, metadataType, metadata)
+		err := nullOps.UpdateMetadata(ctx, entity, entityId, metadataType, metadata)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -168,12 +168,12 @@ func MetadataDelete(ctx context.Context, d *schema.ResourceData, m interface{})
 
 	entity, entityId, metadataType, err := parseMetadataId(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	err = nullOps.DeleteMetadata(entity, entityId, metadataType)
+	err = nullOps.DeleteMetadata(ctx, entity, entityId, metadataType)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +13,10 @@ func resourceMetadataSpecification() *schema.Resource {
 	return &schema.Resource{
 		Description: "The metadata_specification resource allows you to manage entity metadata specifications",
 
-		Create: MetadataSpecificationCreate,
-		Read:   MetadataSpecificationRead,
-		Update: MetadataSpecificationUpdate,
-		Delete: MetadataSpecificationDelete,
+		CreateContext: MetadataSpecificationCreate,
+		ReadContext:   MetadataSpecificationRead,
+		UpdateContext: MetadataSpecificationUpdate,
+		DeleteContext: MetadataSpecificationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -57,7 +58,7 @@ func resourceMetadataSpecification() *schema.Resource {
 	}
 }
 
-func MetadataSpecificationCreate(d *schema.ResourceData, m interface{}) error {
+func MetadataSpecificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -65,16 +66,16 @@ func MetadataSpecificationCreate(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
 	schemaJSON := d.Get("schema").(string)
 	var schemaMap map[string]interface{}
 	if err := json.Unmarshal([]byte(schemaJSON), &schemaMap); err != nil {
-		return fmt.Errorf("error parsing schema JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error parsing schema JSON: %v", err))
 	}
 
 	spec := &MetadataSpecification{
@@ -86,51 +87,51 @@ func MetadataSpecificationCreate(d *schema.ResourceData, m interface{}) error {
 		Schema:      schemaMap,
 	}
 
-	created, err := nullOps.CreateMetadataSpecification(spec)
+	created, err := nullOps.CreateMetadataSpecification(ctx, spec)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(created.Id)
-	return MetadataSpecificationRead(d, m)
+	return MetadataSpecificationRead(ctx, d, m)
 }
 
-func MetadataSpecificationRead(d *schema.ResourceData, m interface{}) error {
+func MetadataSpecificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	spec, err := nullOps.GetMetadataSpecification(d.Id())
+	spec, err := nullOps.GetMetadataSpecification(ctx, d.Id())
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", spec.Description); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("nrn", spec.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("entity", spec.Entity); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("metadata", spec.Metadata); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	schemaJSON, err := json.Marshal(spec.Schema)
 	if err != nil {
-		return fmt.Errorf("error serializing schema to JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error serializing schema to JSON: %v", err))
 	}
 	if err := d.Set("schema", string(schemaJSON)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func MetadataSpecificationUpdate(d *schema.ResourceData, m interface{}) error {
+func MetadataSpecificationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	spec := &MetadataSpecification{
@@ -142,25 +143,25 @@ func MetadataSpecificationUpdate(d *schema.ResourceData, m interface{}) error {
 		schemaJSON := d.Get("schema").(string)
 		var schemaMap map[string]interface{}
 		if err := json.Unmarshal([]byte(schemaJSON), &schemaMap); err != nil {
-			return fmt.Errorf("error parsing schema JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error parsing schema JSON: %v", err))
 		}
 		spec.Schema = schemaMap
 	}
 
-	_, err := nullOps.UpdateMetadataSpecification(d.Id(), spec)
+	_, err := nullOps.UpdateMetadataSpecification(ctx, d.Id(), spec)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return MetadataSpecificationRead(d, m)
+	return MetadataSpecificationRead(ctx, d, m)
 }
 
-func MetadataSpecificationDelete(d *schema.ResourceData, m interface{}) error {
+func MetadataSpecificationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	err := nullOps.DeleteMetadataSpecification(d.Id())
+	err := nullOps.DeleteMetadataSpecification(ctx, d.Id())
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
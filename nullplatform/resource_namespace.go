@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +13,10 @@ func resourceNamespace() *schema.Resource {
 	return &schema.Resource{
 		Description: "The namespace resource allows you to configure a nullplatform namespace",
 
-		Create: NamespaceCreate,
-		Read:   NamespaceRead,
-		Update: NamespaceUpdate,
-		Delete: NamespaceDelete,
+		CreateContext: NamespaceCreate,
+		ReadContext:   NamespaceRead,
+		UpdateContext: NamespaceUpdate,
+		DeleteContext: NamespaceDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -58,7 +59,7 @@ func resourceNamespace() *schema.Resource {
 	}
 }
 
-func NamespaceCreate(d *schema.ResourceData, m any) error {
+func NamespaceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	client := nullOps.(*NullClient)
 
@@ -69,48 +70,44 @@ func NamespaceCreate(d *schema.ResourceData, m any) error {
 		AccountId: d.Get("account_id").(int),
 	}
 
-	namespace, err := client.CreateNamespace(newNamespace)
+	namespace, adoptedAfter, err := client.CreateNamespace(ctx, newNamespace)
 	if err != nil {
-		return fmt.Errorf("error creating namespace: %w", err)
+		return diagFromErr(fmt.Errorf("error creating namespace: %w", err))
 	}
 
 	d.SetId(strconv.Itoa(namespace.Id))
-	return NamespaceRead(d, m)
+	return append(adoptionWarning("namespace", adoptedAfter), NamespaceRead(ctx, d, m)...)
 }
 
-func NamespaceRead(d *schema.ResourceData, m any) error {
+func NamespaceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	namespaceId := d.Id()
 
-	namespace, err := nullOps.GetNamespace(namespaceId)
+	namespace, err := nullOps.GetNamespace(ctx, namespaceId)
 	if err != nil {
-		if namespace != nil && namespace.Status == "inactive" {
-			d.SetId("")
-			return fmt.Errorf("namespace with ID '%s' is inactive and has been removed from state", namespaceId)
-		}
-		return fmt.Errorf("failed to fetch namespace with ID '%s': %w", namespaceId, err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", namespace.Name); err != nil {
-		return fmt.Errorf("failed to set 'name' for namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to set 'name' for namespace with ID '%s': %w", namespaceId, err))
 	}
 	if err := d.Set("slug", namespace.Slug); err != nil {
-		return fmt.Errorf("failed to set 'slug' for namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to set 'slug' for namespace with ID '%s': %w", namespaceId, err))
 	}
 	if err := d.Set("status", namespace.Status); err != nil {
-		return fmt.Errorf("failed to set 'status' for namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to set 'status' for namespace with ID '%s': %w", namespaceId, err))
 	}
 	if err := d.Set("account_id", namespace.AccountId); err != nil {
-		return fmt.Errorf("failed to set 'account_id' for namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to set 'account_id' for namespace with ID '%s': %w", namespaceId, err))
 	}
 	if err := d.Set("nrn", namespace.Nrn); err != nil {
-		return fmt.Errorf("failed to set 'nrn' for namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to set 'nrn' for namespace with ID '%s': %w", namespaceId, err))
 	}
 
 	return nil
 }
 
-func NamespaceUpdate(d *schema.ResourceData, m any) error {
+func NamespaceUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	namespaceId := d.Id()
 
@@ -126,25 +123,21 @@ func NamespaceUpdate(d *schema.ResourceData, m any) error {
 		namespace.Status = d.Get("status").(string)
 	}
 
-	err := nullOps.PatchNamespace(namespaceId, namespace)
+	err := nullOps.PatchNamespace(ctx, namespaceId, namespace)
 	if err != nil {
-		return fmt.Errorf("failed to update namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to update namespace with ID '%s': %w", namespaceId, err))
 	}
 
-	if err := NamespaceRead(d, m); err != nil {
-		return fmt.Errorf("error reading namespace after update for ID '%s': %w", namespaceId, err)
-	}
-
-	return nil
+	return NamespaceRead(ctx, d, m)
 }
 
-func NamespaceDelete(d *schema.ResourceData, m any) error {
+func NamespaceDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	namespaceId := d.Id()
 
-	err := nullOps.DeleteNamespace(namespaceId)
+	err := nullOps.DeleteNamespace(ctx, namespaceId)
 	if err != nil {
-		return fmt.Errorf("failed to delete namespace with ID '%s': %w", namespaceId, err)
+		return diagFromErr(fmt.Errorf("failed to delete namespace with ID '%s': %w", namespaceId, err))
 	}
 
 	d.SetId("")
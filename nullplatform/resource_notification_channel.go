@@ -6,17 +6,18 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceNotificationChannel() *schema.Resource {
 	return &schema.Resource{
-		Description: "The notification channel resource allows you to configure a nullplatform notification channel",
-		Create:      NotificationChannelCreate,
-		Read:        NotificationChannelRead,
-		Update:      NotificationChannelUpdate,
-		Delete:      NotificationChannelDelete,
+		Description:   "The notification channel resource allows you to configure a nullplatform notification channel",
+		CreateContext: NotificationChannelCreate,
+		ReadContext:   NotificationChannelRead,
+		UpdateContext: NotificationChannelUpdate,
+		DeleteContext: NotificationChannelDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -239,7 +240,7 @@ func resourceNotificationChannel() *schema.Resource {
 	}
 }
 
-func NotificationChannelCreate(d *schema.ResourceData, m any) error {
+func NotificationChannelCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	configList := d.Get("configuration").([]interface{})
 	config := configList[0].(map[string]interface{})
@@ -249,12 +250,12 @@ func NotificationChannelCreate(d *schema.ResourceData, m any) error {
 	case "agent":
 		if agents, ok := config["agent"].([]interface{}); ok {
 			if len(agents) != 1 {
-				return fmt.Errorf("agent must be exactly one for agent")
+				return diagFromErr(fmt.Errorf("agent must be exactly one for agent"))
 			}
 			agentMap := agents[0].(map[string]any)
 			if commands, ok := agentMap["command"].([]any); ok {
 				if len(commands) != 1 {
-					return fmt.Errorf("command must be exactly one for agent")
+					return diagFromErr(fmt.Errorf("command must be exactly one for agent"))
 				}
 				if dataMap, ok := commands[0].(map[string]any)["data"].(map[string]any); ok {
 					dataConfig := make(map[string]any)
@@ -262,7 +263,7 @@ func NotificationChannelCreate(d *schema.ResourceData, m any) error {
 						if value != nil {
 							formattedValue, err := deserializeHelper(dataMap[key].(string))
 							if err != nil {
-								return fmt.Errorf("invalid arguments JSON: %w", err)
+								return diagFromErr(fmt.Errorf("invalid arguments JSON: %w", err))
 							}
 							dataConfig[key] = formattedValue
 						}
@@ -332,9 +333,9 @@ func NotificationChannelCreate(d *schema.ResourceData, m any) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v", err)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v", err))
 		}
 	}
 
@@ -361,32 +362,27 @@ func NotificationChannelCreate(d *schema.ResourceData, m any) error {
 	if v, ok := d.GetOk("filters"); ok {
 		var filtersMap map[string]interface{}
 		if err := json.Unmarshal([]byte(v.(string)), &filtersMap); err != nil {
-			return fmt.Errorf("invalid filters JSON: %v", err)
+			return diagFromErr(fmt.Errorf("invalid filters JSON: %v", err))
 		}
 		newChannel.Filters = filtersMap
 	} else {
 		newChannel.Filters = make(map[string]interface{})
 	}
 
-	channel, err := nullOps.CreateNotificationChannel(newChannel)
+	channel, err := nullOps.CreateNotificationChannel(ctx, newChannel)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(channel.Id))
-	return NotificationChannelRead(d, m)
+	return NotificationChannelRead(ctx, d, m)
 }
 
-func NotificationChannelRead(d *schema.ResourceData, m any) error {
+func NotificationChannelRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
-	channel, err := nullOps.GetNotificationChannel(d.Id())
+	channel, err := nullOps.GetNotificationChannel(ctx, d.Id())
 	if err != nil {
-		return err
-	}
-
-	if channel == nil {
-		d.SetId("")
-		return nil
+		return removeIfNotFound(d, err)
 	}
 
 	d.Set("nrn", channel.Nrn)
@@ -411,7 +407,7 @@ func NotificationChannelRead(d *schema.ResourceData, m any) error {
 					if value != nil {
 						objectJson, err := serializeHelper(value)
 						if err != nil {
-							return err
+							return diagFromErr(err)
 						}
 						commandDataMap[key] = objectJson
 					}
@@ -483,13 +479,13 @@ func NotificationChannelRead(d *schema.ResourceData, m any) error {
 	}
 
 	if err := d.Set("configuration", []interface{}{config}); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if channel.Filters != nil && len(channel.Filters) > 0 {
 		filtersJson, err := json.Marshal(channel.Filters)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 		d.Set("filters", string(filtersJson))
 	}
@@ -497,7 +493,7 @@ func NotificationChannelRead(d *schema.ResourceData, m any) error {
 	return nil
 }
 
-func NotificationChannelUpdate(d *schema.ResourceData, m any) error {
+func NotificationChannelUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	if d.HasChanges("type", "configuration", "filters", "status", "description", "source") {
@@ -516,7 +512,7 @@ func NotificationChannelUpdate(d *schema.ResourceData, m any) error {
 							if value != nil {
 								formattedValue, err := deserializeHelper(dataMap[key].(string))
 								if err != nil {
-									return fmt.Errorf("invalid arguments JSON: %w", err)
+									return diagFromErr(fmt.Errorf("invalid arguments JSON: %w", err))
 								}
 								dataConfig[key] = formattedValue
 							}
@@ -604,26 +600,26 @@ func NotificationChannelUpdate(d *schema.ResourceData, m any) error {
 		if v, ok := d.GetOk("filters"); ok {
 			var filtersMap map[string]interface{}
 			if err := json.Unmarshal([]byte(v.(string)), &filtersMap); err != nil {
-				return fmt.Errorf("invalid filters JSON: %v", err)
+				return diagFromErr(fmt.Errorf("invalid filters JSON: %v", err))
 			}
 			updateChannel.Filters = filtersMap
 		}
 
-		if err := nullOps.UpdateNotificationChannel(d.Id(), updateChannel); err != nil {
-			return err
+		if err := nullOps.UpdateNotificationChannel(ctx, d.Id(), updateChannel); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return NotificationChannelRead(d, m)
+	return NotificationChannelRead(ctx, d, m)
 }
 
-func NotificationChannelDelete(d *schema.ResourceData, m any) error {
+func NotificationChannelDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	notificationChannelId := d.Id()
 
-	err := nullOps.DeleteNotificationChannel(notificationChannelId)
+	err := nullOps.DeleteNotificationChannel(ctx, notificationChannelId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
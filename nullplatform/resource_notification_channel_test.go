@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -102,7 +103,7 @@ func testAccCheckNotificationChannelExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundNotificationChannel, err := client.GetNotificationChannel(rs.Primary.ID)
+		foundNotificationChannel, err := client.GetNotificationChannel(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -126,7 +127,7 @@ func testAccCheckNotificationChannelDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetNotificationChannel(rs.Primary.ID)
+		_, err := client.GetNotificationChannel(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Approval policy with ID %s still exists", rs.Primary.ID)
 		}
@@ -190,8 +191,8 @@ func TestNotificationChannelUpdate_SendsSource(t *testing.T) {
 	})
 	d.SetId("123")
 
-	if err := nullplatform.NotificationChannelUpdate(d, client); err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	if diags := nullplatform.NotificationChannelUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 
 	got, ok := patchBody["source"]
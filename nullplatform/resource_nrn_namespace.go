@@ -0,0 +1,245 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNRNNamespace() *schema.Resource {
+	return &schema.Resource{
+		Description: "The nrn_namespace resource manages keys of one namespace stored on an NRN, such as the `k8s` or " +
+			"`gcp` settings of an account. Only the keys in `values` are managed: keys of the namespace set " +
+			"elsewhere are left untouched, and removing a key from `values` removes it from the NRN.",
+
+		CreateContext: NRNNamespaceCreate,
+		ReadContext:   NRNNamespaceRead,
+		UpdateContext: NRNNamespaceUpdate,
+		DeleteContext: NRNNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: NRNNamespaceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nrn": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The NRN the keys are stored on.",
+				ValidateDiagFunc: validateNRN,
+			},
+			"namespace": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The namespace of the keys, such as `aws`, `k8s` or `global`.",
+				ValidateDiagFunc: validateNRNNamespaceName,
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the namespace managed by this resource and their values. Values that are not strings on the NRN are read back JSON encoded, and written back decoded so they keep their type.",
+			},
+		},
+	}
+}
+
+// nrnNamespaceID is the resource ID, <nrn>|<namespace>.
+func nrnNamespaceID(nrn, namespace string) string {
+	return nrn + "|" + namespace
+}
+
+func validateNRNNamespaceName(v interface{}, path cty.Path) diag.Diagnostics {
+	namespace, _ := v.(string)
+	if namespace == "" || strings.ContainsAny(namespace, ".,|") {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid NRN Namespace",
+			Detail:        fmt.Sprintf("%q is not a namespace name; it cannot be empty or contain '.', ',' or '|'.", namespace),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// nrnNamespaceKeys returns the fully qualified namespace.key of each key.
+func nrnNamespaceKeys(namespace string, keys []string) []string {
+	qualified := make([]string, len(keys))
+	for i, key := range keys {
+		qualified[i] = namespace + "." + key
+	}
+	sort.Strings(qualified)
+	return qualified
+}
+
+// nrnNamespaceValues returns the configured values of namespace to PATCH to
+// nrn, each as namespace.key. Values are strings in Terraform; a key whose
+// value on the NRN is not a string, which Read JSON encodes, is decoded so a
+// number, boolean, list or object is not turned into a string.
+func nrnNamespaceValues(ctx context.Context, nullOps NullOps, nrn, namespace string, configured map[string]interface{}) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if len(configured) == 0 {
+		return values, nil
+	}
+
+	keys := make([]string, 0, len(configured))
+	for key := range configured {
+		keys = append(keys, key)
+	}
+	current, err := nullOps.GetNRN(ctx, nrn, nrnNamespaceKeys(namespace, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range configured {
+		values[namespace+"."+key] = value
+		switch current.Namespaces[namespace][key].(type) {
+		case nil, string:
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value.(string)), &decoded); err == nil {
+			values[namespace+"."+key] = decoded
+		}
+	}
+	return values, nil
+}
+
+func NRNNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	nrn := d.Get("nrn").(string)
+	namespace := d.Get("namespace").(string)
+
+	values, err := nrnNamespaceValues(ctx, nullOps, nrn, namespace, d.Get("values").(map[string]interface{}))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	if err := nullOps.PatchNRN(ctx, nrn, values); err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(nrnNamespaceID(nrn, namespace))
+
+	return NRNNamespaceRead(ctx, d, m)
+}
+
+func NRNNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	nrn := d.Get("nrn").(string)
+	namespace := d.Get("namespace").(string)
+
+	var keys []string
+	for key := range d.Get("values").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	n, err := nullOps.GetNRN(ctx, nrn, nrnNamespaceKeys(namespace, keys))
+	if err != nil {
+		return removeIfNotFound(d, err)
+	}
+
+	// Keys removed from the NRN drop out of values, so the next plan sets
+	// them again.
+	values := map[string]string{}
+	for _, key := range keys {
+		if v, ok := n.Namespaces[namespace][key]; ok && v != nil {
+			values[key] = n.Value(namespace, key)
+		}
+	}
+
+	if err := d.Set("values", values); err != nil {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
+
+func NRNNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	nrn := d.Get("nrn").(string)
+	namespace := d.Get("namespace").(string)
+
+	oldValues, newValues := d.GetChange("values")
+
+	values, err := nrnNamespaceValues(ctx, nullOps, nrn, namespace, newValues.(map[string]interface{}))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	for key := range oldValues.(map[string]interface{}) {
+		if _, ok := values[namespace+"."+key]; !ok {
+			values[namespace+"."+key] = nil
+		}
+	}
+
+	if err := nullOps.PatchNRN(ctx, nrn, values); err != nil {
+		return diagFromErr(err)
+	}
+
+	return NRNNamespaceRead(ctx, d, m)
+}
+
+func NRNNamespaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nullOps := m.(NullOps)
+
+	namespace := d.Get("namespace").(string)
+
+	values := map[string]interface{}{}
+	for key := range d.Get("values").(map[string]interface{}) {
+		values[namespace+"."+key] = nil
+	}
+
+	if len(values) > 0 {
+		if err := nullOps.PatchNRN(ctx, d.Get("nrn").(string), values); err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// NRNNamespaceImport imports <nrn>|<namespace>|<key>,<key>,... The keys must
+// be listed because the API cannot list the keys of a namespace.
+func NRNNamespaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <nrn>|<namespace>|<key>,<key>,...", d.Id())
+	}
+	if _, err := ParseNRN(parts[0]); err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, key := range strings.Split(parts[2], ",") {
+		values[key] = ""
+	}
+
+	d.SetId(nrnNamespaceID(parts[0], parts[1]))
+	if err := d.Set("nrn", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("namespace", parts[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("values", values); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,233 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// newNRNKeysTestServer keeps the keys of one NRN, namespace.key to value,
+// and answers PATCH and GET the way /nrn does.
+func newNRNKeysTestServer(t *testing.T, keys map[string]interface{}) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPatch:
+			var patch map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&patch)
+			for key, value := range patch {
+				if value == nil {
+					delete(keys, key)
+				} else {
+					keys[key] = value
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{})
+		case http.MethodGet:
+			namespaces := map[string]map[string]interface{}{}
+			for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+				value, ok := keys[id]
+				if !ok {
+					continue
+				}
+				namespace, key, _ := strings.Cut(id, ".")
+				if namespaces[namespace] == nil {
+					namespaces[namespace] = map[string]interface{}{}
+				}
+				namespaces[namespace][key] = value
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"nrn": "organization=1:account=2", "namespaces": namespaces})
+		}
+	}))
+}
+
+func TestResourceNRNNamespace(t *testing.T) {
+	keys := map[string]interface{}{
+		"k8s.other":       "set elsewhere",
+		"aws.cluster_arn": "arn:aws:eks:cluster",
+	}
+	server := newNRNKeysTestServer(t, keys)
+	defer server.Close()
+	client := newTestClient(server)
+	ctx := context.Background()
+
+	r := resourceNRNNamespace()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"nrn":       "organization=1:account=2",
+		"namespace": "k8s",
+		"values":    map[string]interface{}{"cluster": "main", "replicas": "3"},
+	})
+
+	if diags := NRNNamespaceCreate(ctx, d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "organization=1:account=2|k8s" {
+		t.Errorf("id = %q", d.Id())
+	}
+	if keys["k8s.cluster"] != "main" || keys["k8s.replicas"] != "3" {
+		t.Errorf("keys after create = %v", keys)
+	}
+
+	// A key changed and another deleted outside Terraform show up as drift.
+	keys["k8s.cluster"] = "other"
+	delete(keys, "k8s.replicas")
+	if diags := NRNNamespaceRead(ctx, d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got, want := d.Get("values"), map[string]interface{}{"cluster": "other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("values after drift = %v, want %v", got, want)
+	}
+
+	// Values that are not strings are read back JSON encoded.
+	keys["k8s.cluster"] = map[string]interface{}{"name": "main"}
+	if diags := NRNNamespaceRead(ctx, d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got := d.Get("values.cluster"); got != `{"name":"main"}` {
+		t.Errorf("values.cluster = %v", got)
+	}
+
+	if diags := NRNNamespaceDelete(ctx, d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := map[string]interface{}{"k8s.other": "set elsewhere", "aws.cluster_arn": "arn:aws:eks:cluster"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys after delete = %v, want only the unmanaged ones %v", keys, want)
+	}
+}
+
+func TestResourceNRNNamespaceUpdate_RemovesDroppedKeys(t *testing.T) {
+	var patched map[string]interface{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			json.NewDecoder(r.Body).Decode(&patched)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"namespaces": map[string]interface{}{"k8s": map[string]interface{}{"cluster": "blue"}},
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	r := resourceNRNNamespace()
+	state := &terraform.InstanceState{
+		ID: "organization=1|k8s",
+		Attributes: map[string]string{
+			"id":              "organization=1|k8s",
+			"nrn":             "organization=1",
+			"namespace":       "k8s",
+			"values.%":        "2",
+			"values.cluster":  "green",
+			"values.replicas": "3",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"nrn":       "organization=1",
+		"namespace": "k8s",
+		"values":    map[string]interface{}{"cluster": "blue"},
+	})
+	diff, err := r.SimpleDiff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := NRNNamespaceUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := map[string]interface{}{"k8s.cluster": "blue", "k8s.replicas": nil}
+	if !reflect.DeepEqual(patched, want) {
+		t.Errorf("patched %v, want %v", patched, want)
+	}
+}
+
+func TestNRNNamespaceImport(t *testing.T) {
+	r := resourceNRNNamespace()
+
+	d := r.Data(nil)
+	d.SetId("organization=1:account=2|k8s|cluster,replicas")
+	if _, err := NRNNamespaceImport(context.Background(), d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "organization=1:account=2|k8s" || d.Get("namespace") != "k8s" || len(d.Get("values").(map[string]interface{})) != 2 {
+		t.Errorf("imported id %q, namespace %v, values %v", d.Id(), d.Get("namespace"), d.Get("values"))
+	}
+
+	d = r.Data(nil)
+	d.SetId("organization=1:account=2|k8s")
+	if _, err := NRNNamespaceImport(context.Background(), d, nil); err == nil || !strings.Contains(err.Error(), "<key>,<key>") {
+		t.Errorf("expected an error asking for the keys, got %v", err)
+	}
+}
+
+func TestResourceNRNNamespaceUpdate_KeepsJSONTypes(t *testing.T) {
+	keys := map[string]interface{}{
+		"k8s.replicas": float64(3),
+		"k8s.cluster":  map[string]interface{}{"name": "main"},
+		"k8s.region":   "us-east-1",
+	}
+	server := newNRNKeysTestServer(t, keys)
+	defer server.Close()
+	client := newTestClient(server)
+
+	r := resourceNRNNamespace()
+	state := &terraform.InstanceState{
+		ID: "organization=1:account=2|k8s",
+		Attributes: map[string]string{
+			"id":              "organization=1:account=2|k8s",
+			"nrn":             "organization=1:account=2",
+			"namespace":       "k8s",
+			"values.%":        "3",
+			"values.replicas": "3",
+			"values.cluster":  `{"name":"main"}`,
+			"values.region":   "us-east-1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"nrn":       "organization=1:account=2",
+		"namespace": "k8s",
+		"values": map[string]interface{}{
+			"replicas": "5",
+			"cluster":  `{"name":"blue"}`,
+			"region":   "42",
+		},
+	})
+	diff, err := r.SimpleDiff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := NRNNamespaceUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := map[string]interface{}{
+		"k8s.replicas": float64(5),
+		"k8s.cluster":  map[string]interface{}{"name": "blue"},
+		"k8s.region":   "42",
+	}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+	if got := d.Get("values.cluster"); got != `{"name":"blue"}` {
+		t.Errorf("values.cluster = %v", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,10 +18,10 @@ func resourcePackage() *schema.Resource {
 			"revisions are never mutated. The first publish sticks the package default to that " +
 			"revision; later publishes only move it when `default = true`.",
 
-		Create: PackageCreate,
-		Read:   PackageRead,
-		Update: PackageUpdate,
-		Delete: PackageDelete,
+		CreateContext: PackageCreate,
+		ReadContext:   PackageRead,
+		UpdateContext: PackageUpdate,
+		DeleteContext: PackageDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -42,14 +43,15 @@ func resourcePackage() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The owner NRN of the package. Writes (publishes, patches, delete) are gated on it.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The owner NRN of the package. Writes (publishes, patches, delete) are gated on it.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"slug": {
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
 				Description: "URL-safe identifier, unique per NRN. Together with nrn it is the publish key. " +
 					"Replaces the package only on a real rename (see CustomizeDiff), not when it merely " +
 					"resolves to the same value after apply.",
@@ -104,7 +106,10 @@ func resourcePackage() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
+				},
 				Description: "NRNs allowed to consume (read/link) this package. Supports trailing-wildcard " +
 					"scopes (\"organization=1:account=*\") and the global wildcard \"organization=*\" " +
 					"(requires the write action org-wide). Defaults to [nrn].",
@@ -204,40 +209,40 @@ func configuredDefaultVersion(d *schema.ResourceData) (string, bool) {
 	return attr.AsString(), true
 }
 
-func pinDefaultVersion(nullOps NullOps, packageID, version string) error {
-	revisions, err := nullOps.ListPackageRevisions(packageID)
+func pinDefaultVersion(ctx context.Context, nullOps NullOps, packageID, version string) error {
+	revisions, err := nullOps.ListPackageRevisions(ctx, packageID)
 	if err != nil {
 		return err
 	}
 	for _, revision := range revisions {
 		if revision.Version == version {
-			return nullOps.PatchPackage(packageID, &PackagePatch{DefaultRevisionID: revision.ID})
+			return nullOps.PatchPackage(ctx, packageID, &PackagePatch{DefaultRevisionID: revision.ID})
 		}
 	}
 	return fmt.Errorf("cannot pin default_version: package %s has no published version %s", packageID, version)
 }
 
-func PackageCreate(d *schema.ResourceData, m interface{}) error {
+func PackageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	pkg, err := nullOps.UpsertPackage(buildPackageUpsert(d))
+	pkg, err := nullOps.UpsertPackage(ctx, buildPackageUpsert(d))
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(pkg.ID)
 
 	if version, configured := configuredDefaultVersion(d); configured {
-		if err := pinDefaultVersion(nullOps, pkg.ID, version); err != nil {
-			return err
+		if err := pinDefaultVersion(ctx, nullOps, pkg.ID, version); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	if err := applyPackageTags(nullOps, pkg.ID, nil, toTagMap(d.Get("tags"))); err != nil {
-		return err
+	if err := applyPackageTags(ctx, nullOps, pkg.ID, nil, toTagMap(d.Get("tags"))); err != nil {
+		return diagFromErr(err)
 	}
 
-	return PackageRead(d, m)
+	return PackageRead(ctx, d, m)
 }
 
 // toTagMap coerces a Terraform map attribute into map[string]string.
@@ -254,12 +259,12 @@ func toTagMap(raw interface{}) map[string]string {
 
 // applyPackageTags reconciles declared tags: point added/changed names at
 // their version, delete names that were dropped from config.
-func applyPackageTags(nullOps NullOps, packageID string, previous, desired map[string]string) error {
+func applyPackageTags(ctx context.Context, nullOps NullOps, packageID string, previous, desired map[string]string) error {
 	for name, version := range desired {
 		if previous[name] == version {
 			continue
 		}
-		if err := nullOps.SetPackageTag(packageID, name, &PackageTagSet{Version: version}); err != nil {
+		if err := nullOps.SetPackageTag(ctx, packageID, name, &PackageTagSet{Version: version}); err != nil {
 			return err
 		}
 	}
@@ -267,44 +272,44 @@ func applyPackageTags(nullOps NullOps, packageID string, previous, desired map[s
 		if _, keep := desired[name]; keep {
 			continue
 		}
-		if err := nullOps.DeletePackageTag(packageID, name); err != nil {
+		if err := nullOps.DeletePackageTag(ctx, packageID, name); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func PackageRead(d *schema.ResourceData, m interface{}) error {
+func PackageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	pkg, err := nullOps.GetPackage(d.Id())
+	pkg, err := nullOps.GetPackage(ctx, d.Id())
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", pkg.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", pkg.Slug); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("name", pkg.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", pkg.VisibleTo); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("default_revision_id", pkg.DefaultRevisionID); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("latest_revision_id", pkg.LatestRevisionID); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("default_version", pkg.DefaultVersion); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("latest_version", pkg.LatestVersion); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Reflect the user tags currently on the package (system tags default/
@@ -317,20 +322,20 @@ func PackageRead(d *schema.ResourceData, m interface{}) error {
 		userTags[tag.Name] = tag.Version
 	}
 	if err := d.Set("tags", userTags); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Resolve the revision id of the configured version. Revisions are
 	// immutable, so this only changes when `version` does.
 	version := d.Get("version").(string)
-	revisions, err := nullOps.ListPackageRevisions(pkg.ID)
+	revisions, err := nullOps.ListPackageRevisions(ctx, pkg.ID)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	for _, revision := range revisions {
 		if revision.Version == version {
 			if err := d.Set("published_revision_id", revision.ID); err != nil {
-				return err
+				return diagFromErr(err)
 			}
 			break
 		}
@@ -343,23 +348,23 @@ func PackageRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func PackageUpdate(d *schema.ResourceData, m interface{}) error {
+func PackageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	published := false
 	if d.HasChange("version") || d.HasChange("components") || d.HasChange("visible_to") || d.HasChange("default") {
 		// Publishing is the natural write path and also carries the envelope
 		// fields (name, visible_to) along.
-		if _, err := nullOps.UpsertPackage(buildPackageUpsert(d)); err != nil {
-			return err
+		if _, err := nullOps.UpsertPackage(ctx, buildPackageUpsert(d)); err != nil {
+			return diagFromErr(err)
 		}
 		published = true
 	}
 
 	if !published && d.HasChange("name") {
 		patch := &PackagePatch{Name: d.Get("name").(string)}
-		if err := nullOps.PatchPackage(d.Id(), patch); err != nil {
-			return err
+		if err := nullOps.PatchPackage(ctx, d.Id(), patch); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -367,26 +372,26 @@ func PackageUpdate(d *schema.ResourceData, m interface{}) error {
 	// publish above may have minted the version the pin points at, and the
 	// PATCH is idempotent.
 	if version, configured := configuredDefaultVersion(d); configured {
-		if err := pinDefaultVersion(nullOps, d.Id(), version); err != nil {
-			return err
+		if err := pinDefaultVersion(ctx, nullOps, d.Id(), version); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("tags") {
 		previous, desired := d.GetChange("tags")
-		if err := applyPackageTags(nullOps, d.Id(), toTagMap(previous), toTagMap(desired)); err != nil {
-			return err
+		if err := applyPackageTags(ctx, nullOps, d.Id(), toTagMap(previous), toTagMap(desired)); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return PackageRead(d, m)
+	return PackageRead(ctx, d, m)
 }
 
-func PackageDelete(d *schema.ResourceData, m interface{}) error {
+func PackageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	if err := nullOps.DeletePackage(d.Id()); err != nil {
-		return fmt.Errorf("error deleting package %s: %v", d.Id(), err)
+	if err := nullOps.DeletePackage(ctx, d.Id()); err != nil {
+		return diagFromErr(fmt.Errorf("error deleting package %s: %v", d.Id(), err))
 	}
 
 	d.SetId("")
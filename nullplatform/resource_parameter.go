@@ -2,11 +2,11 @@ package nullplatform
 
 import (
 	"context"
-	"log"
 	"reflect"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,10 +15,10 @@ func resourceParameter() *schema.Resource {
 	return &schema.Resource{
 		Description: "The parameter resource allows you to manage an application parameter.",
 
-		Create: ParameterCreate,
-		Read:   ParameterRead,
-		Update: ParameterUpdate,
-		Delete: ParameterDelete,
+		CreateContext: ParameterCreate,
+		ReadContext:   ParameterRead,
+		UpdateContext: ParameterUpdate,
+		DeleteContext: ParameterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -35,10 +35,11 @@ func resourceParameter() *schema.Resource {
 				Description: "Definition name of the variable.",
 			},
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The NRN of the application to which the parameter belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The NRN of the application to which the parameter belongs to.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -90,7 +91,7 @@ func resourceParameter() *schema.Resource {
 	}
 }
 
-func ParameterCreate(d *schema.ResourceData, m any) error {
+func ParameterCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	newParameter := &Parameter{
@@ -107,9 +108,9 @@ func ParameterCreate(d *schema.ResourceData, m any) error {
 	importIfCreated := d.Get("import_if_created").(bool)
 
 	var param *Parameter
-	err := retry.RetryContext(context.Background(), 1*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 		var err error
-		param, err = nullOps.CreateParameter(newParameter, importIfCreated)
+		param, err = nullOps.CreateParameter(ctx, newParameter, importIfCreated)
 		if err != nil {
 			if isRetryableError(err) {
 				return retry.RetryableError(err)
@@ -120,73 +121,67 @@ func ParameterCreate(d *schema.ResourceData, m any) error {
 	})
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.Set("import_if_created", importIfCreated)
 	d.SetId(strconv.Itoa(param.Id))
 
-	return ParameterRead(d, m)
+	return ParameterRead(ctx, d, m)
 }
 
-func ParameterRead(d *schema.ResourceData, m any) error {
+func ParameterRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	parameterId := d.Id()
 
-	param, err := nullOps.GetParameter(parameterId, nil)
+	param, err := nullOps.GetParameter(ctx, parameterId, nil)
 	if err != nil {
-		// FIXME: Validate if error == 404
-		if !d.IsNewResource() {
-			log.Printf("[WARN] Parameter ID %s not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", param.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("nrn", param.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("type", param.Type); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("encoding", param.Encoding); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("variable", param.Variable); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("destination_path", param.DestinationPath); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("secret", param.Secret); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("read_only", param.ReadOnly); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	// Value stored in the state file not returned by the Null API
 	if importIfCreated, ok := d.GetOk("import_if_created"); ok {
 		if err := d.Set("import_if_created", importIfCreated.(bool)); err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func ParameterUpdate(d *schema.ResourceData, m any) error {
+func ParameterUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	parameterId := d.Id()
@@ -226,23 +221,23 @@ func ParameterUpdate(d *schema.ResourceData, m any) error {
 	}
 
 	if !reflect.DeepEqual(*param, Parameter{}) {
-		err := nullOps.PatchParameter(parameterId, param)
+		err := nullOps.PatchParameter(ctx, parameterId, param)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return ParameterRead(d, m)
+	return ParameterRead(ctx, d, m)
 }
 
-func ParameterDelete(d *schema.ResourceData, m any) error {
+func ParameterDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	parameterId := d.Id()
 
 	if !d.Get("import_if_created").(bool) {
-		err := nullOps.DeleteParameter(parameterId)
+		err := nullOps.DeleteParameter(ctx, parameterId)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
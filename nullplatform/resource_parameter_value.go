@@ -2,13 +2,13 @@ package nullplatform
 
 import (
 	"context"
-	"errors"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,10 +17,11 @@ func resourceParameterValue() *schema.Resource {
 	return &schema.Resource{
 		Description: "The parameter value resource allows you to manage an application or scope parameter value.",
 
-		Create: ParameterValueCreate,
-		Read:   ParameterValueRead,
-		Update: ParameterValueUpdate,
-		Delete: ParameterValueDelete,
+		CreateContext: ParameterValueCreate,
+		ReadContext:   ParameterValueRead,
+		UpdateContext: ParameterValueUpdate,
+		DeleteContext: ParameterValueDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -43,10 +44,11 @@ func resourceParameterValue() *schema.Resource {
 				Description: "Use when you want to create a new value copying the other values from a specific-version (roll back).",
 			},
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The NRN of the application or scope to which the value will apply to (when setting dimensions, the NRN must be at app-level).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The NRN of the application or scope to which the value will apply to (when setting dimensions, the NRN must be at app-level).",
+				ValidateDiagFunc: validateNRN,
 			},
 			"value": {
 				Type:        schema.TypeString,
@@ -62,20 +64,15 @@ func resourceParameterValue() *schema.Resource {
 				},
 				Description: "The dimensions of the value.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 		},
 	}
 }
 
-func ParameterValueCreate(d *schema.ResourceData, m any) error {
+func ParameterValueCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	// FIXME: This code is duplicated in Scope
-	dimensionsMap := d.Get("dimensions").(map[string]any)
-	// Convert the dimensions to a map[string]string
-	dimensions := make(map[string]string)
-	for key, value := range dimensionsMap {
-		dimensions[key] = value.(string)
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	parameterId := d.Get("parameter_id").(int)
 
@@ -87,9 +84,9 @@ func ParameterValueCreate(d *schema.ResourceData, m any) error {
 	}
 
 	var paramValue *ParameterValue
-	err := retry.RetryContext(context.Background(), 1*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 		var err error
-		paramValue, err = nullOps.CreateParameterValue(parameterId, newParameterValue)
+		paramValue, err = nullOps.CreateParameterValue(ctx, parameterId, newParameterValue)
 		if err != nil {
 			if isRetryableError(err) {
 				return retry.RetryableError(err)
@@ -100,7 +97,7 @@ func ParameterValueCreate(d *schema.ResourceData, m any) error {
 	})
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	log.Printf("[DEBUG] Parameter Value Created with OriginID: %s", paramValue.Id)
@@ -108,10 +105,10 @@ func ParameterValueCreate(d *schema.ResourceData, m any) error {
 	paramValueId := generateParameterValueID(paramValue, parameterId)
 	d.SetId(paramValueId)
 
-	return ParameterValueRead(d, m)
+	return ParameterValueRead(ctx, d, m)
 }
 
-func ParameterValueRead(d *schema.ResourceData, m any) error {
+func ParameterValueRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	var parameterValue *ParameterValue
 
 	nullOps := m.(NullOps)
@@ -119,9 +116,9 @@ func ParameterValueRead(d *schema.ResourceData, m any) error {
 	parameterValueId := d.Id()
 	nrn := d.Get("nrn").(string)
 
-	err := retry.RetryContext(context.Background(), 1*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 		var err error
-		parameterValue, err = nullOps.GetParameterValue(parameterId, parameterValueId, &nrn)
+		parameterValue, err = nullOps.GetParameterValue(ctx, parameterId, parameterValueId, &nrn)
 		if err != nil {
 			if isRetryableError(err) {
 				return retry.RetryableError(err)
@@ -132,55 +129,42 @@ func ParameterValueRead(d *schema.ResourceData, m any) error {
 	})
 
 	if err != nil {
-		// FIXME: Validate if error == 404
-		if !d.IsNewResource() {
-			log.Printf("[WARN] Parameter Value ID %s not found, removing value from state", parameterValueId)
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", parameterValue.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("value", parameterValue.Value); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", parameterValue.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, parameterValue.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func ParameterValueUpdate(d *schema.ResourceData, m any) error {
+func ParameterValueUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	if d.HasChange("origin_version") || d.HasChange("value") {
 		nullOps := m.(NullOps)
 
-		// FIXME: This code is duplicated in Scope
-		dimensionsMap := d.Get("dimensions").(map[string]any)
-		// Convert the dimensions to a map[string]string
-		dimensions := make(map[string]string)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-
 		parameterId := d.Get("parameter_id").(int)
 
 		newParameterValue := &ParameterValue{
 			OriginVersion: d.Get("origin_version").(int),
 			Nrn:           d.Get("nrn").(string),
 			Value:         d.Get("value").(string),
+			Dimensions:    dimensionsWithDefaults(d, m),
 		}
 
 		// Updating the value means creating a new version of it
 		var paramValue *ParameterValue
-		err := retry.RetryContext(context.Background(), 1*time.Minute, func() *retry.RetryError {
+		err := retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
 			var err error
-			paramValue, err = nullOps.CreateParameterValue(parameterId, newParameterValue)
+			paramValue, err = nullOps.CreateParameterValue(ctx, parameterId, newParameterValue)
 			if err != nil {
 				if isRetryableError(err) {
 					return retry.RetryableError(err)
@@ -191,7 +175,7 @@ func ParameterValueUpdate(d *schema.ResourceData, m any) error {
 		})
 
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 
 		// The ID of the Parameter Value will change if other value is updated
@@ -203,7 +187,7 @@ func ParameterValueUpdate(d *schema.ResourceData, m any) error {
 	return nil
 }
 
-func ParameterValueDelete(d *schema.ResourceData, m any) error {
+func ParameterValueDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	parameterId := strconv.Itoa(d.Get("parameter_id").(int))
 	parameterValueId := d.Id()
@@ -211,7 +195,7 @@ func ParameterValueDelete(d *schema.ResourceData, m any) error {
 	// FIXME: Most of this logic is duplicated in `ParameterValueRead`
 	var parameterValue *ParameterValue
 
-	param, err := nullOps.GetParameter(parameterId, nil)
+	param, err := nullOps.GetParameter(ctx, parameterId, nil)
 	if err != nil {
 		// FIXME: Validate if error == 404
 		if !d.IsNewResource() {
@@ -219,7 +203,7 @@ func ParameterValueDelete(d *schema.ResourceData, m any) error {
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diagFromErr(err)
 	}
 
 	for _, item := range param.Values {
@@ -234,8 +218,8 @@ func ParameterValueDelete(d *schema.ResourceData, m any) error {
 		return nil
 	}
 
-	err = retry.RetryContext(context.Background(), 1*time.Minute, func() *retry.RetryError {
-		err := nullOps.DeleteParameterValue(parameterId, parameterValue.Id)
+	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		err := nullOps.DeleteParameterValue(ctx, parameterId, parameterValue.Id)
 		if err != nil {
 			if isRetryableError(err) {
 				return retry.RetryableError(err)
@@ -258,11 +242,11 @@ func ParameterValueDelete(d *schema.ResourceData, m any) error {
 }
 
 func isRetryableError(err error) bool {
-	var httpErr *HTTPStatusError
-	if !errors.As(err, &httpErr) {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
 		return false
 	}
-	switch httpErr.StatusCode() {
+	switch apiErr.Status {
 	case http.StatusRequestTimeout,
 		http.StatusConflict,
 		http.StatusTooManyRequests,
@@ -271,7 +255,7 @@ func isRetryableError(err error) bool {
 		http.StatusBadGateway:
 		return true
 	case http.StatusBadRequest:
-		return strings.Contains(httpErr.Message, "already exists")
+		return strings.Contains(apiErr.Message, "already exists")
 	}
 	return false
 }
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -60,7 +61,7 @@ func testAccCheckParameterValueExists(n string, parameterValue *nullplatform.Par
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundParameterValue, err := client.GetParameterValue(rs.Primary.Attributes["parameter_id"], rs.Primary.ID, nil)
+		foundParameterValue, err := client.GetParameterValue(context.Background(), rs.Primary.Attributes["parameter_id"], rs.Primary.ID, nil)
 		if err != nil {
 			return err
 		}
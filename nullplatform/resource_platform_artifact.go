@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,10 +20,10 @@ func resourcePlatformArtifact() *schema.Resource {
 			"component's `resource_revision_id`. Artifacts are immutable records: destroying this " +
 			"resource only removes it from Terraform state.",
 
-		Create: PlatformArtifactCreate,
-		Read:   PlatformArtifactRead,
-		Update: PlatformArtifactUpdate,
-		Delete: PlatformArtifactDelete,
+		CreateContext: PlatformArtifactCreate,
+		ReadContext:   PlatformArtifactRead,
+		UpdateContext: PlatformArtifactUpdate,
+		DeleteContext: PlatformArtifactDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -33,10 +34,11 @@ func resourcePlatformArtifact() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The owner NRN of the artifact. Writes (new revisions, re-scoping) are gated on it.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The owner NRN of the artifact. Writes (new revisions, re-scoping) are gated on it.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -63,7 +65,10 @@ func resourcePlatformArtifact() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
+				},
 				Description: "NRNs allowed to consume (read/link) this artifact. Supports trailing-wildcard " +
 					"scopes (\"organization=1:account=*\") and the global wildcard \"organization=*\" " +
 					"(requires the write action org-wide). Defaults to [nrn].",
@@ -98,51 +103,51 @@ func buildArtifactRegistration(d *schema.ResourceData) (*PlatformArtifactRegistr
 	return registration, nil
 }
 
-func PlatformArtifactCreate(d *schema.ResourceData, m interface{}) error {
+func PlatformArtifactCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	registration, err := buildArtifactRegistration(d)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	revision, err := nullOps.RegisterPlatformArtifact(registration)
+	revision, err := nullOps.RegisterPlatformArtifact(ctx, registration)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(revision.ResourceRevisionID)
 
-	return PlatformArtifactRead(d, m)
+	return PlatformArtifactRead(ctx, d, m)
 }
 
-func PlatformArtifactRead(d *schema.ResourceData, m interface{}) error {
+func PlatformArtifactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	revision, err := nullOps.GetPlatformArtifactRevision(d.Id())
+	revision, err := nullOps.GetPlatformArtifactRevision(ctx, d.Id())
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	metaJSON, err := json.Marshal(revision.Meta)
 	if err != nil {
-		return fmt.Errorf("error serializing artifact meta to JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error serializing artifact meta to JSON: %v", err))
 	}
 
 	if err := d.Set("nrn", revision.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", revision.Type); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("meta", string(metaJSON)); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", revision.VisibleTo); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 	if err := d.Set("artifact_id", revision.ResourceID); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -151,26 +156,26 @@ func PlatformArtifactRead(d *schema.ResourceData, m interface{}) error {
 // PlatformArtifactUpdate only handles visible_to: re-registering the same
 // meta is the API's write path on the existing artifact and re-scopes who
 // can consume it. Everything else is ForceNew.
-func PlatformArtifactUpdate(d *schema.ResourceData, m interface{}) error {
+func PlatformArtifactUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	if d.HasChange("visible_to") {
 		registration, err := buildArtifactRegistration(d)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
-		if _, err := nullOps.RegisterPlatformArtifact(registration); err != nil {
-			return err
+		if _, err := nullOps.RegisterPlatformArtifact(ctx, registration); err != nil {
+			return diagFromErr(err)
 		}
 	}
 
-	return PlatformArtifactRead(d, m)
+	return PlatformArtifactRead(ctx, d, m)
 }
 
 // PlatformArtifactDelete forgets the registration: artifacts are immutable,
 // content-addressed records that package revisions may pin forever, so the
 // API intentionally exposes no delete.
-func PlatformArtifactDelete(d *schema.ResourceData, m interface{}) error {
+func PlatformArtifactDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	d.SetId("")
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,10 +14,11 @@ func resourceProviderConfig() *schema.Resource {
 	return &schema.Resource{
 		Description: "The provider_config resource allows you to configure a nullplatform Provider",
 
-		Create: ProviderConfigCreate,
-		Read:   ProviderConfigRead,
-		Update: ProviderConfigUpdate,
-		Delete: ProviderConfigDelete,
+		CreateContext: ProviderConfigCreate,
+		ReadContext:   ProviderConfigRead,
+		UpdateContext: ProviderConfigUpdate,
+		DeleteContext: ProviderConfigDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -35,6 +37,7 @@ func resourceProviderConfig() *schema.Resource {
 				},
 				Description: "A key-value map with the provider dimensions that apply to this scope. Optional: when omitted, the provider config applies without dimension scoping.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 			"type": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -62,7 +65,7 @@ func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldJSON, newJSON)
 }
 
-func ProviderConfigCreate(d *schema.ResourceData, m interface{}) error {
+func ProviderConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -70,31 +73,24 @@ func ProviderConfigCreate(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
-	var dimensions map[string]string
-	if v, ok := d.GetOk("dimensions"); ok {
-		dimensionsMap := v.(map[string]interface{})
-		dimensions = make(map[string]string, len(dimensionsMap))
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	attributesJSON := d.Get("attributes").(string)
 	var attributes map[string]interface{}
 	if err := json.Unmarshal([]byte(attributesJSON), &attributes); err != nil {
-		return fmt.Errorf("error parsing attributes JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 	}
 
 	typeSlug := d.Get("type").(string)
-	specificationId, err := nullOps.GetSpecificationIdFromSlug(typeSlug, nrn)
+	specificationId, err := nullOps.GetSpecificationIdFromSlug(ctx, typeSlug, nrn)
 	if err != nil {
-		return fmt.Errorf("error fetching specification ID for slug %s: %v", typeSlug, err)
+		return diagFromErr(fmt.Errorf("error fetching specification ID for slug %s: %v", typeSlug, err))
 	}
 
 	newProviderConfig := &ProviderConfig{
@@ -104,54 +100,54 @@ func ProviderConfigCreate(d *schema.ResourceData, m interface{}) error {
 		Attributes:      attributes,
 	}
 
-	pc, err := nullOps.CreateProviderConfig(newProviderConfig)
+	pc, err := nullOps.CreateProviderConfig(ctx, newProviderConfig)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(pc.Id)
-	return ProviderConfigRead(d, m)
+	return ProviderConfigRead(ctx, d, m)
 }
 
-func ProviderConfigRead(d *schema.ResourceData, m interface{}) error {
+func ProviderConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	providerConfigId := d.Id()
 
-	pc, err := nullOps.GetProviderConfig(providerConfigId)
+	pc, err := nullOps.GetProviderConfig(ctx, providerConfigId)
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", pc.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", pc.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, pc.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
-	specificationSlug, err := nullOps.GetSpecificationSlugFromId(pc.SpecificationId)
+	specificationSlug, err := nullOps.GetSpecificationSlugFromId(ctx, pc.SpecificationId)
 	if err != nil {
-		return fmt.Errorf("error fetching specification slug for ID %s: %v", pc.SpecificationId, err)
+		return diagFromErr(fmt.Errorf("error fetching specification slug for ID %s: %v", pc.SpecificationId, err))
 	}
 
 	if err := d.Set("type", specificationSlug); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	attributesJSON, err := json.Marshal(pc.Attributes)
 	if err != nil {
-		return fmt.Errorf("error serializing attributes to JSON: %v", err)
+		return diagFromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
 	}
 
 	if err := d.Set("attributes", string(attributesJSON)); err != nil {
-		return fmt.Errorf("error setting attributes in state: %v", err)
+		return diagFromErr(fmt.Errorf("error setting attributes in state: %v", err))
 	}
 
 	return nil
 }
 
-func ProviderConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func ProviderConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	providerConfigId := d.Id()
 
@@ -161,26 +157,26 @@ func ProviderConfigUpdate(d *schema.ResourceData, m interface{}) error {
 		attributesJSON := d.Get("attributes").(string)
 		var attributes map[string]interface{}
 		if err := json.Unmarshal([]byte(attributesJSON), &attributes); err != nil {
-			return fmt.Errorf("error parsing attributes JSON: %v", err)
+			return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 		}
 		pc.Attributes = attributes
 	}
 
-	err := nullOps.PatchProviderConfig(providerConfigId, pc)
+	err := nullOps.PatchProviderConfig(ctx, providerConfigId, pc)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return ProviderConfigRead(d, m)
+	return ProviderConfigRead(ctx, d, m)
 }
 
-func ProviderConfigDelete(d *schema.ResourceData, m interface{}) error {
+func ProviderConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	providerConfigId := d.Id()
 
-	err := nullOps.DeleteProviderConfig(providerConfigId)
+	err := nullOps.DeleteProviderConfig(ctx, providerConfigId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
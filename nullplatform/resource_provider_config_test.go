@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -48,7 +49,7 @@ func testAccCheckProviderConfigExists(n string, pc *nullplatform.ProviderConfig)
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundProviderConfig, err := client.GetProviderConfig(rs.Primary.ID)
+		foundProviderConfig, err := client.GetProviderConfig(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -74,7 +75,7 @@ func testAccCheckProviderConfigDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetProviderConfig(rs.Primary.ID)
+		_, err := client.GetProviderConfig(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("ProviderConfig with ID %s still exists", rs.Primary.ID)
 		}
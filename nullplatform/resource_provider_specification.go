@@ -50,8 +50,9 @@ func resourceProviderSpecification() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type:     schema.TypeString,
-					MinItems: 1,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
+					MinItems:         1,
 				},
 				Description: "List of NRNs this specification is visible to",
 			},
@@ -104,7 +105,7 @@ func resourceProviderSpecification() *schema.Resource {
 	}
 }
 
-func CreateProviderSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func CreateProviderSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	visibleToRaw := d.Get("visible_to").([]interface{})
@@ -116,13 +117,13 @@ func CreateProviderSpecification(_ context.Context, d *schema.ResourceData, m in
 	schemaStr := d.Get("schema").(string)
 	var specSchema map[string]interface{}
 	if err := json.Unmarshal([]byte(schemaStr), &specSchema); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing schema JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing schema JSON: %v", err))
 	}
 
 	defaultDimensionsStr := d.Get("default_dimensions").(string)
 	var defaultDimensions map[string]interface{}
 	if err := json.Unmarshal([]byte(defaultDimensionsStr), &defaultDimensions); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing default_dimensions JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing default_dimensions JSON: %v", err))
 	}
 
 	spec := &ProviderSpecification{
@@ -143,49 +144,49 @@ func CreateProviderSpecification(_ context.Context, d *schema.ResourceData, m in
 		spec.Category = v.(string)
 	}
 
-	newSpec, err := nullOps.CreateProviderSpecification(spec)
+	newSpec, err := nullOps.CreateProviderSpecification(ctx, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newSpec.Id)
-	return ReadProviderSpecification(context.Background(), d, m)
+	return ReadProviderSpecification(ctx, d, m)
 }
 
-func ReadProviderSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ReadProviderSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	specId := d.Id()
 
-	spec, err := nullOps.GetProviderSpecification(specId)
+	spec, err := nullOps.GetProviderSpecification(ctx, specId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("icon", spec.Icon); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", spec.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("visible_to", spec.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("allow_dimensions", spec.AllowDimensions); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	schemaJSON, err := json.Marshal(spec.SpecSchema)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing schema to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing schema to JSON: %v", err))
 	}
 	if err := d.Set("schema", string(schemaJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	defaultDimensions := spec.DefaultDimensions
@@ -194,10 +195,10 @@ func ReadProviderSpecification(_ context.Context, d *schema.ResourceData, m inte
 	}
 	defaultDimensionsJSON, err := json.Marshal(defaultDimensions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing default_dimensions to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing default_dimensions to JSON: %v", err))
 	}
 	if err := d.Set("default_dimensions", string(defaultDimensionsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	categoryIds := make([]string, len(spec.Categories))
@@ -205,15 +206,15 @@ func ReadProviderSpecification(_ context.Context, d *schema.ResourceData, m inte
 		categoryIds[i] = c.Id
 	}
 	if err := d.Set("categories", categoryIds); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("dependencies", spec.Dependencies); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if spec.OrganizationId != nil {
 		if err := d.Set("organization_id", *spec.OrganizationId); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -247,7 +248,7 @@ func UpdateProviderSpecification(ctx context.Context, d *schema.ResourceData, m
 		schemaStr := d.Get("schema").(string)
 		var specSchema map[string]interface{}
 		if err := json.Unmarshal([]byte(schemaStr), &specSchema); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing schema JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing schema JSON: %v", err))
 		}
 		spec.SpecSchema = specSchema
 	}
@@ -258,7 +259,7 @@ func UpdateProviderSpecification(ctx context.Context, d *schema.ResourceData, m
 		defaultDimensionsStr := d.Get("default_dimensions").(string)
 		var defaultDimensions map[string]interface{}
 		if err := json.Unmarshal([]byte(defaultDimensionsStr), &defaultDimensions); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing default_dimensions JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing default_dimensions JSON: %v", err))
 		}
 		spec.DefaultDimensions = defaultDimensions
 	}
@@ -266,19 +267,19 @@ func UpdateProviderSpecification(ctx context.Context, d *schema.ResourceData, m
 		spec.Category = d.Get("category").(string)
 	}
 
-	if err := nullOps.PatchProviderSpecification(specId, spec); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.PatchProviderSpecification(ctx, specId, spec); err != nil {
+		return diagFromErr(err)
 	}
 
 	return ReadProviderSpecification(ctx, d, m)
 }
 
-func DeleteProviderSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func DeleteProviderSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	specId := d.Id()
 
-	if err := nullOps.DeleteProviderSpecification(specId); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteProviderSpecification(ctx, specId); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -58,7 +59,7 @@ func testAccCheckProviderSpecificationExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		spec, err := client.GetProviderSpecification(rs.Primary.ID)
+		spec, err := client.GetProviderSpecification(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching provider specification: %s", err)
 		}
@@ -82,7 +83,7 @@ func testAccCheckProviderSpecificationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetProviderSpecification(rs.Primary.ID)
+		_, err := client.GetProviderSpecification(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("ProviderSpecification with ID %s still exists", rs.Primary.ID)
 		}
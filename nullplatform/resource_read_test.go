@@ -0,0 +1,167 @@
+package nullplatform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// readTestConfig holds the ID and the attributes a resource needs in state
+// for its Read to reach the API; resources not listed only need an ID.
+var readTestConfig = map[string]struct {
+	id  string
+	raw map[string]interface{}
+}{
+	"nullplatform_action_specification": {
+		raw: map[string]interface{}{"service_specification_id": "spec-1"},
+	},
+	"nullplatform_approval_action_policy_association": {
+		raw: map[string]interface{}{"approval_action_id": "1", "approval_policy_id": "2"},
+	},
+	"nullplatform_dimension_value": {
+		raw: map[string]interface{}{"dimension_id": 1},
+	},
+	"nullplatform_metadata": {
+		id: "application/1/links",
+	},
+	"nullplatform_nrn_namespace": {
+		raw: map[string]interface{}{"nrn": "organization=1", "namespace": "k8s", "values": map[string]interface{}{"cluster": "main"}},
+	},
+	"nullplatform_parameter_value": {
+		raw: map[string]interface{}{"parameter_id": 1, "nrn": "organization=1:account=2"},
+	},
+	"nullplatform_service_action": {
+		raw: map[string]interface{}{"service_id": "service-1"},
+	},
+}
+
+func newReadTestData(t *testing.T, name string, r *schema.Resource) *schema.ResourceData {
+	t.Helper()
+
+	cfg := readTestConfig[name]
+	raw := cfg.raw
+	if raw == nil {
+		raw = map[string]interface{}{}
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	id := cfg.id
+	if id == "" {
+		id = "1"
+	}
+	d.SetId(id)
+	return d
+}
+
+func sortedResourceNames() []string {
+	names := make([]string, 0, len(Provider().ResourcesMap))
+	for name := range Provider().ResourcesMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestResourceRead_RemovesFromStateOnNotFound(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Not found"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	resources := Provider().ResourcesMap
+
+	for _, name := range sortedResourceNames() {
+		r := resources[name]
+		t.Run(name, func(t *testing.T) {
+			d := newReadTestData(t, name, r)
+
+			diags := r.ReadContext(context.Background(), d, client)
+			if diags.HasError() {
+				t.Fatalf("Read returned %v, want the resource removed from state", diags)
+			}
+			if d.Id() != "" {
+				t.Errorf("Read kept ID %q, want it cleared", d.Id())
+			}
+		})
+	}
+}
+
+func TestResourceRead_KeepsStateOnOtherErrors(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"Forbidden"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	resources := Provider().ResourcesMap
+
+	for _, name := range sortedResourceNames() {
+		r := resources[name]
+		t.Run(name, func(t *testing.T) {
+			d := newReadTestData(t, name, r)
+			id := d.Id()
+
+			diags := r.ReadContext(context.Background(), d, client)
+			if !diags.HasError() {
+				t.Fatal("Read succeeded, want the 403 reported")
+			}
+			if d.Id() != id {
+				t.Errorf("Read changed the ID to %q, want %q kept", d.Id(), id)
+			}
+		})
+	}
+}
+
+func TestResourceRead_RemovesSoftDeletedEntities(t *testing.T) {
+	tests := map[string]string{
+		"nullplatform_account":            "deleted",
+		"nullplatform_approval_action":    "deleted",
+		"nullplatform_approval_policy":    "deleted",
+		"nullplatform_entity_hook_action": "deleted",
+		"nullplatform_namespace":          "deleted",
+		"nullplatform_scope":              "deleting",
+	}
+
+	resources := Provider().ResourcesMap
+	for name, status := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"status":"` + status + `"}`))
+			}))
+			defer server.Close()
+
+			r := resources[name]
+			d := newReadTestData(t, name, r)
+
+			diags := r.ReadContext(context.Background(), d, newTestClient(server))
+			if diags.HasError() {
+				t.Fatalf("Read returned %v, want the resource removed from state", diags)
+			}
+			if d.Id() != "" {
+				t.Errorf("Read kept ID %q, want it cleared", d.Id())
+			}
+		})
+	}
+}
+
+func TestRemoveIfNotFound_NewResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceScope().Schema, map[string]interface{}{})
+	d.SetId("1")
+	d.MarkNewResource()
+
+	err := &ResourceNotFoundError{ApiType: "scope", Message: "gone"}
+	if diags := removeIfNotFound(d, err); !diags.HasError() {
+		t.Error("removeIfNotFound() hid a missing entity right after Create")
+	}
+	if d.Id() != "1" {
+		t.Errorf("ID = %q, want it kept", d.Id())
+	}
+}
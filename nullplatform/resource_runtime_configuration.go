@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,10 +13,11 @@ func resourceRuntimeConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Description: "The runtime configuration resource allows you to configure a nullplatform Runtime Configurations",
 
-		Create: RuntimeConfigurationCreate,
-		Read:   RuntimeConfigurationRead,
-		Update: RuntimeConfigurationUpdate,
-		Delete: RuntimeConfigurationeDelete,
+		CreateContext: RuntimeConfigurationCreate,
+		ReadContext:   RuntimeConfigurationRead,
+		UpdateContext: RuntimeConfigurationUpdate,
+		DeleteContext: RuntimeConfigurationeDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -26,9 +28,10 @@ func resourceRuntimeConfiguration() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "A system-wide unique ID representing the resource.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "A system-wide unique ID representing the resource.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"dimensions": {
 				Type:     schema.TypeMap,
@@ -39,6 +42,7 @@ func resourceRuntimeConfiguration() *schema.Resource {
 				},
 				Description: "A key-value map with the runtime configuration dimensions that apply to this scope.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 			"values": {
 				Type:     schema.TypeMap,
 				Required: true,
@@ -51,15 +55,10 @@ func resourceRuntimeConfiguration() *schema.Resource {
 	}
 }
 
-func RuntimeConfigurationCreate(d *schema.ResourceData, m any) error {
+func RuntimeConfigurationCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	dimensionsMap := d.Get("dimensions").(map[string]any)
-	// Convert the dimensions to a map[string]string
-	dimensions := make(map[string]string)
-	for key, value := range dimensionsMap {
-		dimensions[key] = value.(string)
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	valuesMap := d.Get("values").(map[string]any)
 	values := make(map[string]string)
@@ -73,43 +72,43 @@ func RuntimeConfigurationCreate(d *schema.ResourceData, m any) error {
 		Values:     RuntimeConfigurationValues{AWS: values},
 	}
 
-	rc, err := nullOps.CreateRuntimeConfiguration(newRuntimeConfig)
+	rc, err := nullOps.CreateRuntimeConfiguration(ctx, newRuntimeConfig)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(rc.Id))
 
-	return RuntimeConfigurationRead(d, m)
+	return RuntimeConfigurationRead(ctx, d, m)
 }
 
-func RuntimeConfigurationRead(d *schema.ResourceData, m any) error {
+func RuntimeConfigurationRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	runtimeConfigId := d.Id()
 
-	rc, err := nullOps.GetRuntimeConfiguration(runtimeConfigId)
+	rc, err := nullOps.GetRuntimeConfiguration(ctx, runtimeConfigId)
 
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", rc.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", rc.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, rc.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("values", rc.Values.AWS); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func RuntimeConfigurationUpdate(d *schema.ResourceData, m any) error {
+func RuntimeConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	runtimeConfigId := d.Id()
 
@@ -119,16 +118,8 @@ func RuntimeConfigurationUpdate(d *schema.ResourceData, m any) error {
 		rc.Nrn = d.Get("nrn").(string)
 	}
 
-	if d.HasChange("dimensions") {
-		dimensionsMap := d.Get("dimensions").(map[string]interface{})
-
-		// Convert the dimensions to a map[string]string
-		dimensions := make(map[string]string)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-
-		rc.Dimensions = dimensions
+	if d.HasChanges("dimensions", "dimensions_all") {
+		rc.Dimensions = dimensionsWithDefaults(d, m)
 	}
 
 	if d.HasChange("values") {
@@ -142,22 +133,22 @@ func RuntimeConfigurationUpdate(d *schema.ResourceData, m any) error {
 	}
 
 	if !reflect.DeepEqual(*rc, RuntimeConfiguration{}) {
-		err := nullOps.PatchRuntimeConfiguration(runtimeConfigId, rc)
+		err := nullOps.PatchRuntimeConfiguration(ctx, runtimeConfigId, rc)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return RuntimeConfigurationRead(d, m)
+	return RuntimeConfigurationRead(ctx, d, m)
 }
 
-func RuntimeConfigurationeDelete(d *schema.ResourceData, m any) error {
+func RuntimeConfigurationeDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	runtimeConfigId := d.Id()
 
-	err := nullOps.DeleteRuntimeConfiguration(runtimeConfigId)
+	err := nullOps.DeleteRuntimeConfiguration(ctx, runtimeConfigId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -49,7 +50,7 @@ func testAccCheckRuntimeConfigurationExists(n string, rc *int) resource.TestChec
 			return fmt.Errorf("provider meta is nil, ensure the provider is properly configured and initialized")
 		}
 
-		foundRuntimeConfig, err := client.GetRuntimeConfiguration(rs.Primary.ID)
+		foundRuntimeConfig, err := client.GetRuntimeConfiguration(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -75,7 +76,7 @@ func testAccCheckRuntimeConfigurationDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetRuntimeConfiguration(rs.Primary.ID)
+		_, err := client.GetRuntimeConfiguration(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Runtime Configuration with ID %s still exists", rs.Primary.ID)
 		}
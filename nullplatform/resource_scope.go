@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,10 +14,11 @@ func resourceScope() *schema.Resource {
 	return &schema.Resource{
 		Description: "The scope resource allows you to configure a Nullplatform Scope",
 
-		Create: ScopeCreate,
-		Read:   ScopeRead,
-		Update: ScopeUpdate,
-		Delete: ScopeDelete,
+		CreateContext: ScopeCreate,
+		ReadContext:   ScopeRead,
+		UpdateContext: ScopeUpdate,
+		DeleteContext: ScopeDelete,
+		CustomizeDiff: dimensionsCustomizeDiff(scopeApplicationNRN),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -160,6 +162,7 @@ func resourceScope() *schema.Resource {
 				},
 				Description: "A key-value map with the runtime configuration dimensions that apply to this scope.",
 			},
+			"dimensions_all": dimensionsAllSchema(true),
 			"runtime_configurations": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -172,7 +175,7 @@ func resourceScope() *schema.Resource {
 	}
 }
 
-func ScopeCreate(d *schema.ResourceData, m any) error {
+func ScopeCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	applicationId := d.Get("null_application_id").(int)
@@ -186,12 +189,7 @@ func ScopeCreate(d *schema.ResourceData, m any) error {
 	serverless_ephemeral_storage := d.Get("capabilities_serverless_ephemeral_storage").(int)
 	serverless_memory := d.Get("capabilities_serverless_memory").(int)
 
-	dimensionsMap := d.Get("dimensions").(map[string]any)
-	// Convert the dimensions to a map[string]string
-	dimensions := make(map[string]string)
-	for key, value := range dimensionsMap {
-		dimensions[key] = value.(string)
-	}
+	dimensions := dimensionsWithDefaults(d, m)
 
 	newScope := &Scope{
 		Name:            scopeName,
@@ -230,170 +228,146 @@ func ScopeCreate(d *schema.ResourceData, m any) error {
 		Dimensions: dimensions,
 	}
 
-	s, err := nullOps.CreateScope(newScope)
+	s, adoptedAfter, err := nullOps.CreateScope(ctx, newScope)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	nrnErr := patchNrnForScope(s.Nrn, d, m)
+	diags := adoptionWarning("scope", adoptedAfter)
+
+	nrnErr := patchNrnForScope(ctx, s.Nrn, d, m)
 
 	if nrnErr != nil {
-		return nrnErr
+		return append(diags, diagFromErr(nrnErr)...)
 	}
 
 	d.SetId(strconv.Itoa(s.Id))
 
-	return ScopeRead(d, m)
+	return append(diags, ScopeRead(ctx, d, m)...)
 }
 
-func patchNrnForScope(scopeNrn string, d *schema.ResourceData, m any) error {
-	nullOps := m.(NullOps)
+// scopeNRNKeys are the deprecated scope attributes stored as aws.* keys on
+// the scope's NRN.
+var scopeNRNKeys = []struct {
+	attribute string
+	key       string
+}{
+	{"s3_assets_bucket", "s3_assets_bucket"},
+	{"scope_workflow_role", "scope_workflow_role"},
+	{"log_group_name", "log_group_name"},
+	{"lambda_function_name", "lambdaFunctionName"},
+	{"lambda_current_function_version", "lambdaCurrentFunctionVersion"},
+	{"lambda_function_role", "lambdaFunctionRole"},
+	{"lambda_function_main_alias", "lambdaFunctionMainAlias"},
+	{"log_reader_role", "log_reader_role"},
+	{"lambda_function_warm_alias", "lambdaFunctionWarmAlias"},
+}
 
-	s3AssetsBucket := d.Get("s3_assets_bucket").(string)
-	scopeWorkflowRole := d.Get("scope_workflow_role").(string)
-	logGroupName := d.Get("log_group_name").(string)
-	lambdaFunctinoName := d.Get("lambda_function_name").(string)
-	lambdaCurrentFunctionVersion := d.Get("lambda_current_function_version").(string)
-	lambdaFunctionRole := d.Get("lambda_function_role").(string)
-	lambdaFunctionMainAlias := d.Get("lambda_function_main_alias").(string)
-	logReaderRole := d.Get("log_reader_role").(string)
-	lambdaFunctionWarmAlias := d.Get("lambda_function_warm_alias").(string)
+func patchNrnForScope(ctx context.Context, scopeNrn string, d *schema.ResourceData, m any) error {
+	nullOps := m.(NullOps)
 
-	nrnReq := &PatchNRN{
-		AWSS3AssestBucket:               s3AssetsBucket,
-		AWSScopeWorkflowRole:            scopeWorkflowRole,
-		AWSLogGroupName:                 logGroupName,
-		AWSLambdaFunctionName:           lambdaFunctinoName,
-		AWSLambdaCurrentFunctionVersion: lambdaCurrentFunctionVersion,
-		AWSLambdaFunctionRole:           lambdaFunctionRole,
-		AWSLambdaFunctionMainAlias:      lambdaFunctionMainAlias,
-		AWSLogReaderLog:                 logReaderRole,
-		AWSLambdaFunctionWarmAlias:      lambdaFunctionWarmAlias,
+	// Empty attributes are not patched, so they never clear a key set
+	// elsewhere.
+	values := map[string]interface{}{}
+	for _, k := range scopeNRNKeys {
+		if v := d.Get(k.attribute).(string); v != "" {
+			values["aws."+k.key] = v
+		}
 	}
 
-	if !reflect.DeepEqual(nrnReq, PatchNRN{}) {
-		return nullOps.PatchNRN(scopeNrn, nrnReq)
+	if len(values) > 0 {
+		return nullOps.PatchNRN(ctx, scopeNrn, values)
 	}
 
 	return nil
 }
 
-func ScopeRead(d *schema.ResourceData, m any) error {
+func ScopeRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	scopeID := d.Id()
 
-	s, err := nullOps.GetScope(scopeID)
+	s, err := nullOps.GetScope(ctx, scopeID)
 
 	if err != nil {
-		if s != nil && (s.Status == "deleted" || s.Status == "deleting") {
-			d.SetId("")
-			return nil
-		}
-		return err
+		return removeIfNotFound(d, err)
 	}
 
-	n, err := nullOps.GetNRN(s.Nrn)
+	keys := make([]string, len(scopeNRNKeys))
+	for i, k := range scopeNRNKeys {
+		keys[i] = "aws." + k.key
+	}
+	n, err := nullOps.GetNRN(ctx, s.Nrn, keys)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("nrn", s.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("scope_name", s.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("description", s.Description); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("scope_type", s.Type); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("scope_asset_name", s.AssetName); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("null_application_id", s.ApplicationId); err != nil {
-		return err
-	}
-
-	if err := d.Set("s3_assets_bucket", n.Namespaces.AWS.AWSS3AssestBucket); err != nil {
-		return err
-	}
-
-	if err := d.Set("scope_workflow_role", n.Namespaces.AWS.AWSScopeWorkflowRole); err != nil {
-		return err
-	}
-
-	if err := d.Set("log_group_name", n.Namespaces.AWS.AWSLogGroupName); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("lambda_function_name", n.Namespaces.AWS.AWSLambdaFunctionName); err != nil {
-		return err
-	}
-
-	if err := d.Set("lambda_current_function_version", n.Namespaces.AWS.AWSLambdaCurrentFunctionVersion); err != nil {
-		return err
-	}
-
-	if err := d.Set("lambda_function_role", n.Namespaces.AWS.AWSLambdaFunctionRole); err != nil {
-		return err
-	}
-
-	if err := d.Set("lambda_function_main_alias", n.Namespaces.AWS.AWSLambdaFunctionMainAlias); err != nil {
-		return err
-	}
-
-	if err := d.Set("log_reader_role", n.Namespaces.AWS.AWSLogReaderLog); err != nil {
-		return err
-	}
-
-	if err := d.Set("lambda_function_warm_alias", n.Namespaces.AWS.AWSLambdaFunctionWarmAlias); err != nil {
-		return err
+	for _, k := range scopeNRNKeys {
+		if err := d.Set(k.attribute, n.Value("aws", k.key)); err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	if err := d.Set("capabilities_serverless_handler_name", s.Capabilities.ServerlessHandler["name"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("capabilities_serverless_timeout", s.Capabilities.ServerlessTimeout["timeout_in_seconds"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("capabilities_serverless_runtime_id", s.Capabilities.ServerlessRuntime["id"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("capabilities_serverless_runtime_platform", s.Capabilities.ServerlessRuntime["platform"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("capabilities_serverless_ephemeral_storage", s.Capabilities.ServerlessEphemeralStorage["memory_in_mb"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("capabilities_serverless_memory", s.Capabilities.ServerlessMemory["memory_in_mb"]); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("runtime_configurations", s.RuntimeConfigurations); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", s.Dimensions); err != nil {
-		return err
+	if err := setDimensions(d, m, s.Dimensions); err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func ScopeUpdate(d *schema.ResourceData, m any) error {
+func ScopeUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	scopeID := d.Id()
@@ -418,16 +392,8 @@ func ScopeUpdate(d *schema.ResourceData, m any) error {
 		ps.AssetName = d.Get("scope_asset_name").(string)
 	}
 
-	if d.HasChange("dimensions") {
-		dimensionsMap := d.Get("dimensions").(map[string]interface{})
-
-		// Convert the dimensions to a map[string]string
-		dimensions := make(map[string]string)
-		for key, value := range dimensionsMap {
-			dimensions[key] = value.(string)
-		}
-
-		ps.Dimensions = dimensions
+	if d.HasChanges("dimensions", "dimensions_all") {
+		ps.Dimensions = dimensionsWithDefaults(d, m)
 	}
 
 	caps := &Capability{}
@@ -473,30 +439,30 @@ func ScopeUpdate(d *schema.ResourceData, m any) error {
 		d.HasChange("lambda_function_name") || d.HasChange("lambda_current_function_version") || d.HasChange("lambda_function_role") ||
 		d.HasChange("lambda_function_main_alias") || d.HasChange("log_reader_role") || d.HasChange("lambda_function_warm_alias") {
 
-		nrnErr := patchNrnForScope(d.Get("nrn").(string), d, m)
+		nrnErr := patchNrnForScope(ctx, d.Get("nrn").(string), d, m)
 		if nrnErr != nil {
-			return nrnErr
+			return diagFromErr(nrnErr)
 		}
 
 	}
 
 	if !reflect.DeepEqual(*ps, Scope{}) {
-		err := nullOps.PatchScope(scopeID, ps)
+		err := nullOps.PatchScope(ctx, scopeID, ps)
 		if err != nil {
-			return err
+			return diagFromErr(err)
 		}
 	}
 
-	return ScopeRead(d, m)
+	return ScopeRead(ctx, d, m)
 }
 
-func ScopeDelete(d *schema.ResourceData, m any) error {
+func ScopeDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	scopeId := d.Id()
 
-	err := nullOps.DeleteScope(scopeId)
+	err := nullOps.DeleteScope(ctx, scopeId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -67,9 +67,9 @@ func ScopeDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{
 		Type:    d.Get("type").(string),
 	}
 
-	sd, err := nullOps.CreateScopeDomain(newSD)
+	sd, err := nullOps.CreateScopeDomain(ctx, newSD)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(sd.Id)
@@ -80,36 +80,36 @@ func ScopeDomainRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	nullOps := m.(NullOps)
 	sdId := d.Id()
 
-	sd, err := nullOps.GetScopeDomain(sdId)
+	sd, err := nullOps.GetScopeDomain(ctx, sdId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", sd.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", sd.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", sd.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// scope_id is not returned at the top level; recover it from the selector
 	// (mainly to support `terraform import`).
 	if scopeId, ok := sd.Selector["scopeId"]; ok {
 		if err := d.Set("scope_id", fmt.Sprintf("%v", scopeId)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if sd.Selector != nil {
 		selectorJSON, err := json.Marshal(sd.Selector)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing selector to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing selector to JSON: %v", err))
 		}
 		if err := d.Set("selector", string(selectorJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -129,8 +129,8 @@ func ScopeDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		sd.Status = d.Get("status").(string)
 	}
 
-	if err := nullOps.PatchScopeDomain(sdId, sd); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.PatchScopeDomain(ctx, sdId, sd); err != nil {
+		return diagFromErr(err)
 	}
 
 	return ScopeDomainRead(ctx, d, m)
@@ -140,8 +140,8 @@ func ScopeDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{
 	nullOps := m.(NullOps)
 	sdId := d.Id()
 
-	if err := nullOps.DeleteScopeDomain(sdId); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteScopeDomain(ctx, sdId); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -1,6 +1,7 @@
 package nullplatform_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
@@ -43,7 +44,7 @@ func TestResourceScope(t *testing.T) {
 				PreConfig: func() {
 					client := testAccProviders["nullplatform"].Meta().(nullplatform.NullOps)
 					scopeIDStr := strconv.Itoa(scopeID)
-					err := client.DeleteScope(scopeIDStr)
+					err := client.DeleteScope(context.Background(), scopeIDStr)
 					if err != nil {
 						t.Fatalf("Error deleting scope: %s", err)
 					}
@@ -85,7 +86,7 @@ func testAccCheckResourceScopeExists(n string, scopeID *int) resource.TestCheckF
 		}
 
 		client := testAccProviders["nullplatform"].Meta().(nullplatform.NullOps)
-		scope, err := client.GetScope(rs.Primary.ID)
+		scope, err := client.GetScope(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching scope: %s", err)
 		}
@@ -107,7 +108,7 @@ func testAccCheckResourceScopeDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := client.GetScope(rs.Primary.ID)
+		_, err := client.GetScope(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("scope with ID %s still exists", rs.Primary.ID)
 		}
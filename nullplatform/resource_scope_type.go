@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -12,10 +13,10 @@ func resourceScopeType() *schema.Resource {
 	return &schema.Resource{
 		Description: "The scope_type resource allows you to configure a nullplatform Scope Type",
 
-		Create: ScopeTypeCreate,
-		Read:   ScopeTypeRead,
-		Update: ScopeTypeUpdate,
-		Delete: ScopeTypeDelete,
+		CreateContext: ScopeTypeCreate,
+		ReadContext:   ScopeTypeRead,
+		UpdateContext: ScopeTypeUpdate,
+		DeleteContext: ScopeTypeDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -66,7 +67,7 @@ func resourceScopeType() *schema.Resource {
 	}
 }
 
-func ScopeTypeCreate(d *schema.ResourceData, m interface{}) error {
+func ScopeTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	var nrn string
@@ -74,9 +75,9 @@ func ScopeTypeCreate(d *schema.ResourceData, m interface{}) error {
 	if v, ok := d.GetOk("nrn"); ok {
 		nrn = v.(string)
 	} else {
-		nrn, err = ConstructNRNFromComponents(d, nullOps)
+		nrn, err = ConstructNRNFromComponents(ctx, d, nullOps)
 		if err != nil {
-			return fmt.Errorf("error constructing NRN: %v %s", err, nrn)
+			return diagFromErr(fmt.Errorf("error constructing NRN: %v %s", err, nrn))
 		}
 	}
 
@@ -90,58 +91,58 @@ func ScopeTypeCreate(d *schema.ResourceData, m interface{}) error {
 		ProviderId:   d.Get("provider_id").(string),
 	}
 
-	st, err := nullOps.CreateScopeType(newScopeType)
+	st, err := nullOps.CreateScopeType(ctx, newScopeType)
 
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%d", st.Id))
 
-	return ScopeTypeRead(d, m)
+	return ScopeTypeRead(ctx, d, m)
 }
 
-func ScopeTypeRead(d *schema.ResourceData, m interface{}) error {
+func ScopeTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	scopeTypeId := d.Id()
 
-	st, err := nullOps.GetScopeType(scopeTypeId)
+	st, err := nullOps.GetScopeType(ctx, scopeTypeId)
 	if err != nil {
-		return err
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("nrn", st.Nrn); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("type", st.Type); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("name", st.Name); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("status", st.Status); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("description", st.Description); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("provider_type", st.ProviderType); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("provider_id", st.ProviderId); err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	return nil
 }
 
-func ScopeTypeUpdate(d *schema.ResourceData, m interface{}) error {
+func ScopeTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	scopeTypeId := d.Id()
 
@@ -155,21 +156,21 @@ func ScopeTypeUpdate(d *schema.ResourceData, m interface{}) error {
 		updateScopeType.Description = d.Get("description").(string)
 	}
 
-	err := nullOps.PatchScopeType(scopeTypeId, updateScopeType)
+	err := nullOps.PatchScopeType(ctx, scopeTypeId, updateScopeType)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
-	return ScopeTypeRead(d, m)
+	return ScopeTypeRead(ctx, d, m)
 }
 
-func ScopeTypeDelete(d *schema.ResourceData, m interface{}) error {
+func ScopeTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	scopeTypeId := d.Id()
 
-	err := nullOps.DeleteScopeType(scopeTypeId)
+	err := nullOps.DeleteScopeType(ctx, scopeTypeId)
 	if err != nil {
-		return err
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
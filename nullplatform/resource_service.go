@@ -2,14 +2,17 @@ package nullplatform
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceService() *schema.Resource {
@@ -20,6 +23,7 @@ func resourceService() *schema.Resource {
 		ReadContext:   ServiceReadContext,
 		UpdateContext: ServiceUpdateContext,
 		DeleteContext: ServiceDeleteContext,
+		CustomizeDiff: dimensionsCustomizeDiff(nrnAttribute("entity_nrn")),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -30,6 +34,7 @@ func resourceService() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -46,15 +51,17 @@ func resourceService() *schema.Resource {
 				Description: "Unique identifier for the entity represented as a UUID.",
 			},
 			"entity_nrn": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "NRN representing a hierarchical identifier for nullplatform resourcesValue must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "NRN representing a hierarchical identifier for nullplatform resourcesValue must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
+				ValidateDiagFunc: validateNRN,
 			},
 			"linkable_to": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
 				},
 				Description: "A list of NRN representing the visibility settings for the entity. Specifies what/who can see this entity. Value must match regular expression `^organization=[0-9]+(:account=[0-9]+)?(:namespace=[0-9]+)?(:application=[0-9]+)?(:scope=[0-9]+)?$`.",
 			},
@@ -70,8 +77,9 @@ func resourceService() *schema.Resource {
 				ForceNew: true,
 				Description: "When true (default), provisioning and decommissioning of the " +
 					"underlying infrastructure are handled externally to nullplatform. " +
-					"When false, the specification's create and delete actions are triggered " +
-					"to handle the infrastructure lifecycle.",
+					"When false, the specification's create, update and delete actions are " +
+					"triggered to handle the infrastructure lifecycle; a failed update action " +
+					"restores the previous attributes.",
 			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -87,6 +95,18 @@ func resourceService() *schema.Resource {
 					"apply is an update rather than a replace. Has no effect when " +
 					"`import = true`, where destroy already uses force.",
 			},
+			"on_action_in_progress": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      onActionInProgressWait,
+				ValidateFunc: validation.StringInSlice([]string{onActionInProgressWait, onActionInProgressAdopt, onActionInProgressFail}, false),
+				Description: "Only meaningful when `import = false`. What to do when the service already has an action " +
+					"in progress, such as one left running by an interrupted apply, before running another: `wait` " +
+					"(default) for it to end, `adopt` it instead of starting a new action when it is of the same " +
+					"specification, or `fail`. An action the provider stops waiting for, because Terraform was " +
+					"interrupted or the timeout elapsed, is cancelled. A service whose create action was interrupted is " +
+					"kept pending with a warning, and the next apply runs its create action again.",
+			},
 			"messages": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -98,6 +118,21 @@ func resourceService() *schema.Resource {
 				},
 				Description: "A message and its severity level",
 			},
+			"results": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The results of the latest successful create or update action of the service, when `import = false`. " +
+					"Numbers and booleans are formatted as the action specification's results schema types them, and " +
+					"objects and arrays are JSON encoded. Results the schema marks secret are in `sensitive_results`.",
+			},
+			"sensitive_results": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The results of the same action that its results schema marks secret.",
+			},
 			"attributes": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -114,6 +149,7 @@ func resourceService() *schema.Resource {
 				},
 				Description: "Object representing dimensions with key-value pairs.",
 			},
+			"dimensions_all": dimensionsAllSchema(false),
 			"selectors": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -172,7 +208,7 @@ func ServiceCreateContext(ctx context.Context, d *schema.ResourceData, m any) di
 	}
 	messages := d.Get("messages").([]interface{})
 	attributes := d.Get("attributes").(map[string]interface{})
-	dimensions := d.Get("dimensions").(map[string]interface{})
+	dimensions := mapOfStringsToMapOfInterfaces(dimensionsWithDefaults(d, m))
 	selectorsList := d.Get("selectors").([]interface{})
 	var selectors Selectors
 	if len(selectorsList) > 0 {
@@ -198,65 +234,94 @@ func ServiceCreateContext(ctx context.Context, d *schema.ResourceData, m any) di
 		Dimensions:             dimensions,
 	}
 
-	s, err := nullOps.CreateService(newService)
+	s, adoptedAfter, err := nullOps.CreateService(ctx, newService)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(s.Id)
+	diags := adoptionWarning("service", adoptedAfter)
 
 	if !importMode(d) {
 		attrs, _ := d.Get("attributes").(map[string]interface{})
-		if err := triggerServiceAction(ctx, nullOps, s.Id, s.SpecificationId, "create", attrs, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return diag.FromErr(err)
+		createSpec, action, err := triggerServiceAction(ctx, nullOps, s.Id, s.SpecificationId, "create", attrs, d.Get("on_action_in_progress").(string), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return append(diags, createActionInterrupted(d, err)...)
+		}
+		if err := setActionResults(d, action, createSpec.Results); err != nil {
+			return append(diags, diagFromErr(err)...)
 		}
 	}
 
-	return nil
+	return diags
+}
+
+// servicePendingCreateStatuses are the statuses of a service whose create
+// action has not finished.
+var servicePendingCreateStatuses = []string{"pending_create", "pending", "creating"}
+
+// createActionInterrupted reports a failed create action. An interrupted one
+// is only a warning: failing the create would taint the service, and the next
+// apply would replace it instead of picking the action up. The service is
+// kept pending instead, so the next apply runs the create action again,
+// which waits for or adopts the interrupted one as on_action_in_progress
+// says.
+func createActionInterrupted(d *schema.ResourceData, err error) diag.Diagnostics {
+	var orphanedErr *OrphanedActionError
+	if !errors.As(err, &orphanedErr) {
+		return diagFromErr(err)
+	}
+	if err := d.Set("status", "pending"); err != nil {
+		return diagFromErr(err)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Create Action Interrupted",
+		Detail:   fmt.Sprintf("%v\n\nThe service was kept pending, and the next apply runs its create action again.", err),
+	}}
 }
 
-func ServiceReadContext(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func ServiceReadContext(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	serviceID := d.Id()
 
-	s, err := nullOps.GetService(serviceID)
+	s, err := nullOps.GetService(ctx, serviceID)
 
 	if err != nil {
-		d.SetId("")
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", s.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("specification_id", s.SpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("desired_specification_id", s.DesiredSpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("entity_nrn", s.EntityNrn); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("linkable_to", s.LinkableTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("status", s.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	if err := d.Set("dimensions", s.Dimensions); err != nil {
-		return diag.FromErr(err)
+	if err := setDimensions(d, m, mapOfInterfacesToMapOfStrings(s.Dimensions)); err != nil {
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("messages", s.Messages); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	selectors := []map[string]interface{}{
@@ -268,18 +333,83 @@ func ServiceReadContext(_ context.Context, d *schema.ResourceData, m any) diag.D
 		},
 	}
 	if err := d.Set("selectors", selectors); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	attributeMap := mapOfInterfacesToMapOfStrings(s.Attributes)
 	if err := d.Set("attributes", attributeMap); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	return nil
+	// Imported services never run actions, so they have no results.
+	if importMode(d) {
+		return nil
+	}
+	return readServiceResults(ctx, nullOps, d, s)
+}
+
+// readServiceResults sets results and sensitive_results from the latest
+// successful create or update action of the service, wherever it was run
+// from, so actions run outside Terraform show up too. The results are only
+// informational, so failing to list the actions or their specifications is a
+// warning that leaves the results in state as they were.
+func readServiceResults(ctx context.Context, nullOps NullOps, d *schema.ResourceData, s *Service) diag.Diagnostics {
+	actions, err := nullOps.ListServiceActions(ctx, s.Id)
+	if err != nil {
+		return serviceResultsNotRead(s.Id, fmt.Errorf("listing its actions: %w", err))
+	}
+
+	var succeeded []*ActionInstance
+	for _, a := range actions {
+		if a.Status == "success" {
+			succeeded = append(succeeded, a)
+		}
+	}
+	if len(succeeded) == 0 {
+		return diagFromErr(setActionResults(d, nil, nil))
+	}
+
+	specs, err := nullOps.ListActionSpecifications(ctx, s.SpecificationId)
+	if err != nil {
+		return serviceResultsNotRead(s.Id, fmt.Errorf("listing action specifications: %w", err))
+	}
+	specsByID := map[string]*ActionSpecification{}
+	for _, spec := range specs {
+		specsByID[spec.Id] = spec
+	}
+
+	// Actions without a created_at, or with one that does not parse, count
+	// in the order listed, oldest first.
+	var latest *ActionInstance
+	var latestSpec *ActionSpecification
+	var latestCreatedAt time.Time
+	for _, a := range succeeded {
+		spec, ok := specsByID[a.SpecificationId]
+		if !ok || (spec.Type != "create" && spec.Type != "update") {
+			continue
+		}
+		createdAt, _ := time.Parse(time.RFC3339Nano, a.CreatedAt)
+		if latest == nil || !createdAt.Before(latestCreatedAt) {
+			latest, latestSpec, latestCreatedAt = a, spec, createdAt
+		}
+	}
+	if latest == nil {
+		return diagFromErr(setActionResults(d, nil, nil))
+	}
+	return diagFromErr(setActionResults(d, latest, latestSpec.Results))
 }
 
-func ServiceUpdateContext(_ context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+// serviceResultsNotRead warns that the results of a service could not be
+// refreshed.
+func serviceResultsNotRead(serviceID string, err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Could Not Read Service Results",
+		Detail:   fmt.Sprintf("Reading the results of service %s failed, so results and sensitive_results keep their previous values: %v", serviceID, err),
+	}}
+}
+
+func ServiceUpdateContext(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	serviceID := d.Id()
@@ -292,7 +422,12 @@ func ServiceUpdateContext(_ context.Context, d *schema.ResourceData, m any) diag
 		ps.Name = d.Get("name").(string)
 	}
 
-	if d.HasChange("status") {
+	// A service left pending by an interrupted create action is finished by
+	// running the create action again, which moves it to active itself.
+	oldStatus, _ := d.GetChange("status")
+	finishCreate := !importMode(d) && slices.Contains(servicePendingCreateStatuses, oldStatus.(string))
+
+	if d.HasChange("status") && !finishCreate {
 		ps.Status = d.Get("status").(string)
 	}
 
@@ -308,10 +443,32 @@ func ServiceUpdateContext(_ context.Context, d *schema.ResourceData, m any) diag
 		ps.LinkableTo = d.Get("linkable_to").([]interface{})
 	}
 
-	if d.HasChange("dimensions") {
-		dimensions := d.Get("dimensions").(map[string]interface{})
+	if d.HasChanges("dimensions", "dimensions_all") {
+		ps.Dimensions = mapOfStringsToMapOfInterfaces(dimensionsWithDefaults(d, m))
+	}
+
+	var diags diag.Diagnostics
 
-		ps.Dimensions = dimensions
+	// With import = false the infrastructure follows the specification's
+	// actions, so an attribute change runs its update action; a PATCH alone
+	// would only change the record.
+	var updateSpec *ActionSpecification
+	if d.HasChange("attributes") && !importMode(d) && !finishCreate {
+		specificationID := d.Get("specification_id").(string)
+		specs, err := nullOps.ListActionSpecifications(ctx, specificationID)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("listing action specifications: %w", err))
+		}
+		updateSpec, err = findActionSpecByType(specs, "update")
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "No Update Action",
+				Detail: fmt.Sprintf("Specification %s has no update action, so the new attributes are only saved "+
+					"on the service and its infrastructure is not changed.", specificationID),
+				AttributePath: cty.GetAttrPath("attributes"),
+			})
+		}
 	}
 
 	if d.HasChange("attributes") {
@@ -334,13 +491,56 @@ func ServiceUpdateContext(_ context.Context, d *schema.ResourceData, m any) diag
 	}
 
 	if !reflect.DeepEqual(*ps, Service{}) {
-		err := nullOps.PatchService(serviceID, ps)
+		err := nullOps.PatchService(ctx, serviceID, ps)
 		if err != nil {
-			return diag.FromErr(err)
+			return append(diags, diagFromErr(err)...)
 		}
 	}
 
-	return nil
+	if updateSpec != nil {
+		attrs := d.Get("attributes").(map[string]interface{})
+		actions := serviceActions(nullOps, serviceID)
+		actions.onInProgress = d.Get("on_action_in_progress").(string)
+		action, err := actions.run(ctx, updateSpec, attrs, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			// Keep the previous attributes in state so the next plan
+			// retries the update.
+			d.Partial(true)
+			var orphanedErr *OrphanedActionError
+			if errors.As(err, &orphanedErr) {
+				// Interrupted: there is no context left to restore with,
+				// and the next apply runs the update again.
+				return append(diags, diagFromErr(err)...)
+			}
+			oldAttrs, _ := d.GetChange("attributes")
+			return append(diags, recoverFailedUpdate(ctx, "service", serviceID, err,
+				func() error {
+					return nullOps.PatchService(ctx, serviceID, &Service{Attributes: oldAttrs.(map[string]interface{})})
+				},
+				func() error {
+					return nullOps.PatchService(ctx, serviceID, &Service{Status: "failed"})
+				},
+			)...)
+		}
+		if err := setActionResults(d, action, updateSpec.Results); err != nil {
+			return append(diags, diagFromErr(err)...)
+		}
+	}
+
+	if finishCreate {
+		attrs := d.Get("attributes").(map[string]interface{})
+		createSpec, action, err := triggerServiceAction(ctx, nullOps, serviceID, d.Get("specification_id").(string), "create", attrs, d.Get("on_action_in_progress").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			// Keep the service pending so the next apply tries again.
+			d.Partial(true)
+			return append(diags, createActionInterrupted(d, err)...)
+		}
+		if err := setActionResults(d, action, createSpec.Results); err != nil {
+			return append(diags, diagFromErr(err)...)
+		}
+	}
+
+	return diags
 }
 
 func ServiceDeleteContext(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -348,8 +548,8 @@ func ServiceDeleteContext(ctx context.Context, d *schema.ResourceData, m any) di
 	serviceID := d.Id()
 
 	if importMode(d) || d.Get("force_destroy").(bool) {
-		if err := nullOps.DeleteService(serviceID, true); err != nil {
-			return diag.FromErr(err)
+		if err := nullOps.DeleteService(ctx, serviceID, true); err != nil {
+			return diagFromErr(err)
 		}
 		d.SetId("")
 		return nil
@@ -361,11 +561,11 @@ func ServiceDeleteContext(ctx context.Context, d *schema.ResourceData, m any) di
 	// case by reading the live status and force-delete instead. Without this,
 	// users would have to either (a) untaint+apply force_destroy=true into
 	// state then destroy, or (b) manually clean up via the API.
-	current, err := nullOps.GetService(serviceID)
+	current, err := nullOps.GetService(ctx, serviceID)
 	if err == nil && current != nil && current.Status == "failed" {
 		log.Printf("[INFO] service %s is in status=failed; force-deleting instead of triggering delete action", serviceID)
-		if err := nullOps.DeleteService(serviceID, true); err != nil {
-			return diag.FromErr(err)
+		if err := nullOps.DeleteService(ctx, serviceID, true); err != nil {
+			return diagFromErr(err)
 		}
 		d.SetId("")
 		return nil
@@ -373,72 +573,22 @@ func ServiceDeleteContext(ctx context.Context, d *schema.ResourceData, m any) di
 
 	specificationID := d.Get("specification_id").(string)
 	attrs, _ := d.Get("attributes").(map[string]interface{})
-	if err := triggerServiceAction(ctx, nullOps, serviceID, specificationID, "delete", attrs, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return diag.FromErr(err)
+	if _, _, err := triggerServiceAction(ctx, nullOps, serviceID, specificationID, "delete", attrs, d.Get("on_action_in_progress").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-const actionPollInterval = 15 * time.Second
-
-func waitForActionTerminal(ctx context.Context, nullOps NullOps, serviceID, actionID string, timeout time.Duration) (*ActionInstance, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{"pending_create", "pending", "in_progress"},
-		Target:  []string{"success"},
-		Refresh: func() (interface{}, string, error) {
-			a, err := nullOps.GetServiceAction(serviceID, actionID)
-			if err != nil {
-				return nil, "", err
-			}
-			if a.Status == "failed" || a.Status == "cancelled" {
-				return a, a.Status, fmt.Errorf("action %s ended in status %q: %s",
-					actionID, a.Status, summarizeMessages(a.Messages))
-			}
-			return a, a.Status, nil
-		},
-		Timeout:    timeout,
-		Delay:      actionPollInterval,
-		MinTimeout: actionPollInterval,
-	}
-	raw, err := stateConf.WaitForStateContext(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if a, ok := raw.(*ActionInstance); ok {
-		return a, nil
-	}
-	return nil, nil
-}
-
-func triggerServiceAction(ctx context.Context, nullOps NullOps, serviceID, specificationID, actionType string, attributes map[string]interface{}, timeout time.Duration) error {
-	specs, err := nullOps.ListActionSpecifications(specificationID)
-	if err != nil {
-		return fmt.Errorf("listing action specifications: %w", err)
-	}
-	actionSpec, err := findActionSpecByType(specs, actionType)
+func triggerServiceAction(ctx context.Context, nullOps NullOps, serviceID, specificationID, actionType string, attributes map[string]interface{}, onInProgress string, timeout time.Duration) (*ActionSpecification, *ActionInstance, error) {
+	specs, err := nullOps.ListActionSpecifications(ctx, specificationID)
 	if err != nil {
-		return fmt.Errorf("specification %s: %w", specificationID, err)
+		return nil, nil, fmt.Errorf("listing action specifications: %w", err)
 	}
-
-	parameters, err := projectAttributesToParameters(attributes, actionSpec.Parameters)
-	if err != nil {
-		return fmt.Errorf("projecting attributes onto %s action parameter schema: %w", actionType, err)
-	}
-
-	action, err := nullOps.CreateServiceAction(serviceID, &ActionInstance{
-		SpecificationId: actionSpec.Id,
-		Parameters:      parameters,
-	})
-	if err != nil {
-		return fmt.Errorf("creating %s action: %w", actionType, err)
-	}
-
-	if _, err := waitForActionTerminal(ctx, nullOps, serviceID, action.Id, timeout); err != nil {
-		return err
-	}
-	return nil
+	actions := serviceActions(nullOps, serviceID)
+	actions.onInProgress = onInProgress
+	return actions.trigger(ctx, specs, specificationID, actionType, attributes, timeout)
 }
 
 // importMode reads the `import` attribute defensively. The schema's `Default: true`
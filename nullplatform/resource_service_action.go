@@ -3,11 +3,15 @@ package nullplatform
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceServiceAction() *schema.Resource {
@@ -16,6 +20,7 @@ func resourceServiceAction() *schema.Resource {
 
 		CreateContext: ServiceActionCreate,
 		ReadContext:   ServiceActionRead,
+		UpdateContext: ServiceActionUpdate,
 		DeleteContext: ServiceActionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -25,11 +30,27 @@ func resourceServiceAction() *schema.Resource {
 					return nil, fmt.Errorf("invalid import ID %q, expected format <service_id>/<action_id>", d.Id())
 				}
 				d.Set("service_id", parts[0])
+				d.Set("wait_for_completion", true)
+				d.Set("max_retries", defaultServiceActionMaxRetries)
+				d.Set("on_action_in_progress", onActionInProgressWait)
 				d.SetId(parts[1])
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceServiceActionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: serviceActionStateUpgradeV0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Type:        schema.TypeString,
@@ -50,20 +71,99 @@ func resourceServiceAction() *schema.Resource {
 				Description:      "JSON string containing the parameters for the action",
 				DiffSuppressFunc: suppressEquivalentJSON,
 			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the action again when any of them changes, like the `triggers_replace` of `terraform_data`.",
+			},
+			"on_action_in_progress": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      onActionInProgressWait,
+				ValidateFunc: validation.StringInSlice([]string{onActionInProgressWait, onActionInProgressAdopt, onActionInProgressFail}, false),
+				Description: "What to do when the service already has an action in progress, such as one left running " +
+					"by an interrupted apply: `wait` (default) for it to end before running this one, `adopt` it " +
+					"instead of running this one when it is of the same specification, or `fail`. An action the " +
+					"provider stops waiting for, because Terraform was interrupted or the timeout elapsed, is cancelled.",
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether to wait for the action to succeed or fail, within the create timeout. When false, " +
+					"the action is only started and `status` is whatever it was right after. Defaults to `true`.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultServiceActionMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "How many more times a failed action is run, when its specification is `retryable` and " +
+					"`wait_for_completion` is true. Every run shares the create timeout. Defaults to `2`.",
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The current status of the action",
 			},
 			"results": {
-				Type:        schema.TypeString,
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "The results produced by the action. Numbers and booleans are formatted as the action " +
+					"specification's results schema types them, and objects and arrays are JSON encoded. Results " +
+					"the schema marks secret are in `sensitive_results`. This used to be a JSON string, which " +
+					"`results_json` keeps for one release.",
+			},
+			"sensitive_results": {
+				Type:        schema.TypeMap,
 				Computed:    true,
-				Description: "JSON string containing the results produced by the action",
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The results produced by the action that its results schema marks secret.",
 			},
+			"results_json": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+				Description: "Deprecated: use `results` and `sensitive_results` instead; this attribute will be removed in " +
+					"a future release. Every result produced by the action, secret or not, JSON encoded, as `results` " +
+					"was before it became a map, so `jsondecode` of it keeps working.",
+			},
+		},
+	}
+}
+
+// resourceServiceActionV0 is the schema before results became a map, when
+// they were a JSON string.
+func resourceServiceActionV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service_id":       {Type: schema.TypeString, Required: true},
+			"specification_id": {Type: schema.TypeString, Required: true},
+			"parameters":       {Type: schema.TypeString, Optional: true},
+			"status":           {Type: schema.TypeString, Computed: true},
+			"results":          {Type: schema.TypeString, Computed: true},
 		},
 	}
 }
 
+// serviceActionStateUpgradeV0 moves the JSON string results to results_json,
+// and the next read sets results again as a map.
+func serviceActionStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if results, ok := rawState["results"]; ok {
+		rawState["results_json"] = results
+		delete(rawState, "results")
+	}
+	return rawState, nil
+}
+
+// defaultServiceActionMaxRetries is the retry budget of actions whose
+// specification is retryable.
+const defaultServiceActionMaxRetries = 2
+
 func ServiceActionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
@@ -77,59 +177,178 @@ func ServiceActionCreate(ctx context.Context, d *schema.ResourceData, m interfac
 	if parametersStr, ok := d.GetOk("parameters"); ok {
 		var parameters map[string]interface{}
 		if err := json.Unmarshal([]byte(parametersStr.(string)), &parameters); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing parameters JSON: %v", err))
 		}
 		action.Parameters = parameters
 	}
 
-	newAction, err := nullOps.CreateServiceAction(serviceID, action)
+	actions := serviceActions(nullOps, serviceID)
+	actions.onInProgress = d.Get("on_action_in_progress").(string)
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	// An action of the same specification left running by an interrupted
+	// apply may be adopted rather than run a second time.
+	running, err := actions.inProgress(ctx, action.SpecificationId, time.Until(deadline))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+	if running != nil {
+		d.SetId(running.Id)
+	} else {
+		if err := actions.beforeDeadline(deadline); err != nil {
+			return diagFromErr(err)
+		}
+		newAction, err := nullOps.CreateServiceAction(ctx, serviceID, action)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		d.SetId(newAction.Id)
+	}
+
+	if !d.Get("wait_for_completion").(bool) {
+		return ServiceActionRead(ctx, d, m)
+	}
+
+	maxRetries := d.Get("max_retries").(int)
+	var spec *ActionSpecification
+	for attempt := 1; ; attempt++ {
+		finished, err := actions.waitOrCancel(ctx, d.Id(), time.Until(deadline))
+		if err == nil {
+			break
+		}
+		var orphanedErr *OrphanedActionError
+		if errors.As(err, &orphanedErr) {
+			return diagFromErr(err)
+		}
+		if finished == nil || finished.Status != "failed" {
+			return append(ServiceActionRead(ctx, d, m), diagFromErr(fmt.Errorf("waiting for action %s: %w", d.Id(), err))...)
+		}
+
+		if spec == nil && attempt <= maxRetries {
+			spec, err = nullOps.GetActionSpecification(ctx, action.SpecificationId, "service", "")
+			if err != nil {
+				log.Printf("[WARN] not retrying action %s: reading action specification %s failed: %v", d.Id(), action.SpecificationId, err)
+				spec = &ActionSpecification{}
+			}
+		}
+		if spec == nil || !spec.Retryable || attempt > maxRetries {
+			return append(ServiceActionRead(ctx, d, m), serviceActionFailed(serviceID, finished, attempt))
+		}
+
+		if time.Now().After(deadline) {
+			return append(ServiceActionRead(ctx, d, m), serviceActionFailed(serviceID, finished, attempt))
+		}
+		log.Printf("[WARN] action %s of service %s failed (attempt %d of %d), running it again: %s",
+			d.Id(), serviceID, attempt, maxRetries+1, summarizeMessages(finished.Messages))
+		retried, err := nullOps.CreateServiceAction(ctx, serviceID, action)
+		if err != nil {
+			return append(ServiceActionRead(ctx, d, m), diagFromErr(fmt.Errorf("running action again after it failed: %w", err))...)
+		}
+		d.SetId(retried.Id)
 	}
 
-	d.SetId(newAction.Id)
 	return ServiceActionRead(ctx, d, m)
 }
 
+// serviceActionFailed is the error of an action that failed, with every
+// message it left.
+func serviceActionFailed(serviceID string, action *ActionInstance, attempts int) diag.Diagnostic {
+	detail := fmt.Sprintf("Action %s of service %s ended in status %q", action.Id, serviceID, action.Status)
+	if attempts > 1 {
+		detail += fmt.Sprintf(" after %d attempts", attempts)
+	}
+	detail += "."
+	if messages := formatMessages(action.Messages); messages != "" {
+		detail += "\n\n" + messages
+	}
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Service Action Failed",
+		Detail:   detail,
+	}
+}
+
 func ServiceActionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	serviceID := d.Get("service_id").(string)
 	actionID := d.Id()
 
-	action, err := nullOps.GetServiceAction(serviceID, actionID)
+	action, err := nullOps.GetServiceAction(ctx, serviceID, actionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("specification_id", action.SpecificationId); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("status", action.Status); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if action.Parameters != nil {
 		parametersJSON, err := json.Marshal(action.Parameters)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing parameters to JSON: %v", err))
 		}
 		if err := d.Set("parameters", string(parametersJSON)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
+	resultsJSON := ""
 	if action.Results != nil {
-		resultsJSON, err := json.Marshal(action.Results)
+		encoded, err := json.Marshal(action.Results)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing results to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing results to JSON: %v", err))
 		}
-		if err := d.Set("results", string(resultsJSON)); err != nil {
-			return diag.FromErr(err)
+		resultsJSON = string(encoded)
+	}
+	if err := d.Set("results_json", resultsJSON); err != nil {
+		return diagFromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	var resultsSchema map[string]interface{}
+	if len(action.Results) > 0 {
+		// The action specification is read by its ID alone. It only types
+		// the results, so they are set untyped when it cannot be read.
+		spec, err := nullOps.GetActionSpecification(ctx, action.SpecificationId, "service", "")
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Could Not Read Results Schema",
+				Detail: fmt.Sprintf("Reading action specification %s failed, so the results of action %s are formatted "+
+					"without its results schema, and only the results already in sensitive_results are kept secret: %v",
+					action.SpecificationId, actionID, err),
+			})
+			resultsSchema = knownSecretResults(d)
+		} else {
+			resultsSchema = spec.Results
 		}
 	}
+	if err := setActionResults(d, action, resultsSchema); err != nil {
+		return append(diags, diagFromErr(err)...)
+	}
 
-	return nil
+	return diags
+}
+
+// knownSecretResults is a results schema marking secret the results already
+// in sensitive_results, so that a specification that cannot be read does not
+// move them into results.
+func knownSecretResults(d *schema.ResourceData) map[string]interface{} {
+	properties := map[string]interface{}{}
+	for key := range d.Get("sensitive_results").(map[string]interface{}) {
+		properties[key] = map[string]interface{}{"secret": true}
+	}
+	return map[string]interface{}{"schema": map[string]interface{}{"properties": properties}}
+}
+
+// ServiceActionUpdate only saves the settings that change how the action is
+// run; the action itself runs again only when it is replaced.
+func ServiceActionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return ServiceActionRead(ctx, d, m)
 }
 
 func ServiceActionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -138,8 +357,8 @@ func ServiceActionDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	serviceID := d.Get("service_id").(string)
 	actionID := d.Id()
 
-	if err := nullOps.DeleteServiceAction(serviceID, actionID); err != nil {
-		return diag.FromErr(err)
+	if err := nullOps.DeleteServiceAction(ctx, serviceID, actionID); err != nil {
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
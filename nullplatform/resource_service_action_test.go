@@ -0,0 +1,281 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// serviceActionsRunServer fakes the actions of service svc-1: each action
+// posted takes the next of statuses once it is polled.
+type serviceActionsRunServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	statuses  []string
+	retryable bool
+	posted    []ActionInstance
+}
+
+func newServiceActionsRunServer(t *testing.T, retryable bool, statuses ...string) *serviceActionsRunServer {
+	t.Helper()
+	s := &serviceActionsRunServer{statuses: statuses, retryable: retryable}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/service/svc-1/action" && r.Method == http.MethodPost:
+			var body ActionInstance
+			json.NewDecoder(r.Body).Decode(&body)
+			s.posted = append(s.posted, body)
+			json.NewEncoder(w).Encode(ActionInstance{Id: fmt.Sprintf("act-%d", len(s.posted)), Status: "pending"})
+		case strings.HasPrefix(r.URL.Path, "/service/svc-1/action/act-"):
+			var n int
+			fmt.Sscanf(strings.TrimPrefix(r.URL.Path, "/service/svc-1/action/act-"), "%d", &n)
+			status := "pending"
+			if n >= 1 && n <= len(s.statuses) {
+				status = s.statuses[n-1]
+			}
+			json.NewEncoder(w).Encode(ActionInstance{
+				Id:              fmt.Sprintf("act-%d", n),
+				Status:          status,
+				SpecificationId: "spec-1",
+				Messages: []interface{}{
+					map[string]interface{}{"severity": "info", "message": "starting"},
+					map[string]interface{}{"severity": "error", "message": "quota exceeded"},
+				},
+			})
+		case r.URL.Path == "/action_specification/spec-1":
+			json.NewEncoder(w).Encode(ActionSpecification{Id: "spec-1", Retryable: s.retryable})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func serviceActionData(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	raw := map[string]interface{}{
+		"service_id":       "svc-1",
+		"specification_id": "spec-1",
+	}
+	for k, v := range config {
+		raw[k] = v
+	}
+	r := resourceServiceAction()
+	diff, err := r.SimpleDiff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(nil, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestServiceActionCreate_WaitsForCompletion(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsRunServer(t, false, "success")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceActionData(t, nil)
+	if diags := ServiceActionCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "act-1" || d.Get("status") != "success" {
+		t.Errorf("id = %q, status = %q, want act-1 succeeded", d.Id(), d.Get("status"))
+	}
+}
+
+func TestServiceActionCreate_NoWait(t *testing.T) {
+	server := newServiceActionsRunServer(t, false)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceActionData(t, map[string]interface{}{"wait_for_completion": false})
+	if diags := ServiceActionCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Get("status") != "pending" {
+		t.Errorf("status = %q, want the action left pending", d.Get("status"))
+	}
+}
+
+func TestServiceActionCreate_FailureReportsMessages(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsRunServer(t, false, "failed")
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceActionData(t, nil)
+	diags := ServiceActionCreate(context.Background(), d, client)
+	if !diags.HasError() || diags[len(diags)-1].Summary != "Service Action Failed" {
+		t.Fatalf("diags = %v, want Service Action Failed", diags)
+	}
+	detail := diags[len(diags)-1].Detail
+	if !strings.Contains(detail, "[info] starting") || !strings.Contains(detail, "[error] quota exceeded") {
+		t.Errorf("detail = %q, want every message", detail)
+	}
+	if len(server.posted) != 1 {
+		t.Errorf("posted %d actions, want 1: the specification is not retryable", len(server.posted))
+	}
+	if d.Id() != "act-1" {
+		t.Errorf("id = %q, want the failed action kept in state", d.Id())
+	}
+}
+
+func TestServiceActionCreate_RetriesRetryableActions(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		statuses   []string
+		maxRetries int
+		wantPosted int
+		wantError  bool
+	}{
+		{name: "succeeds on retry", statuses: []string{"failed", "success"}, maxRetries: 2, wantPosted: 2},
+		{name: "exhausts budget", statuses: []string{"failed", "failed", "failed"}, maxRetries: 2, wantPosted: 3, wantError: true},
+		{name: "no budget", statuses: []string{"failed"}, maxRetries: 0, wantPosted: 1, wantError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			shortenActionPolling(t)
+			server := newServiceActionsRunServer(t, true, tc.statuses...)
+			defer server.Close()
+			client := newTestClient(server.Server)
+
+			d := serviceActionData(t, map[string]interface{}{"max_retries": tc.maxRetries})
+			diags := ServiceActionCreate(context.Background(), d, client)
+			if diags.HasError() != tc.wantError {
+				t.Fatalf("diags = %v, want error %v", diags, tc.wantError)
+			}
+			if len(server.posted) != tc.wantPosted {
+				t.Errorf("posted %d actions, want %d", len(server.posted), tc.wantPosted)
+			}
+			if want := fmt.Sprintf("act-%d", tc.wantPosted); d.Id() != want {
+				t.Errorf("id = %q, want the last run %s", d.Id(), want)
+			}
+			if tc.wantError && tc.wantPosted > 1 && !strings.Contains(diags[len(diags)-1].Detail, fmt.Sprintf("after %d attempts", tc.wantPosted)) {
+				t.Errorf("detail = %q, want the number of attempts", diags[len(diags)-1].Detail)
+			}
+		})
+	}
+}
+
+func TestServiceActionRead_Results(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/svc-1/action/act-1":
+			json.NewEncoder(w).Encode(ActionInstance{
+				Id:              "act-1",
+				Status:          "success",
+				SpecificationId: "spec-1",
+				Results:         map[string]interface{}{"port": float64(6379), "password": "hunter2"},
+			})
+		case "/action_specification/spec-1":
+			json.NewEncoder(w).Encode(ActionSpecification{
+				Id: "spec-1",
+				Results: map[string]interface{}{
+					"schema": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"port":     map[string]interface{}{"type": "integer"},
+							"password": map[string]interface{}{"type": "string", "secret": true},
+						},
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	d := resourceServiceAction().TestResourceData()
+	d.SetId("act-1")
+	d.Set("service_id", "svc-1")
+	if diags := ServiceActionRead(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if got := d.Get("results").(map[string]interface{}); len(got) != 1 || got["port"] != "6379" {
+		t.Errorf("results = %v, want the port", got)
+	}
+	if got := d.Get("sensitive_results").(map[string]interface{}); len(got) != 1 || got["password"] != "hunter2" {
+		t.Errorf("sensitive_results = %v, want the password", got)
+	}
+	if got := d.Get("results_json").(string); got != `{"password":"hunter2","port":6379}` {
+		t.Errorf("results_json = %q, want every result", got)
+	}
+}
+
+func TestServiceActionRead_ResultsWithoutSpecification(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/svc-1/action/act-1":
+			json.NewEncoder(w).Encode(ActionInstance{
+				Id:              "act-1",
+				Status:          "success",
+				SpecificationId: "spec-1",
+				Results:         map[string]interface{}{"port": float64(6379), "password": "hunter2"},
+			})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	d := resourceServiceAction().TestResourceData()
+	d.SetId("act-1")
+	d.Set("service_id", "svc-1")
+	d.Set("sensitive_results", map[string]interface{}{"password": "hunter2"})
+	diags := ServiceActionRead(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("diags = %v, want one warning", diags)
+	}
+
+	if got := d.Get("results").(map[string]interface{}); len(got) != 1 || got["port"] != "6379" {
+		t.Errorf("results = %v, want the port", got)
+	}
+	if got := d.Get("sensitive_results").(map[string]interface{}); len(got) != 1 || got["password"] != "hunter2" {
+		t.Errorf("sensitive_results = %v, want the password kept secret", got)
+	}
+}
+
+func TestServiceActionStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":               "act-1",
+		"service_id":       "svc-1",
+		"specification_id": "spec-1",
+		"status":           "success",
+		"results":          `{"port":6379}`,
+	}
+	got, err := serviceActionStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got["results"]; ok {
+		t.Errorf("results = %v, want the JSON string moved", got["results"])
+	}
+	if got["results_json"] != `{"port":6379}` {
+		t.Errorf("results_json = %v, want the JSON string", got["results_json"])
+	}
+	if got["service_id"] != "svc-1" || got["status"] != "success" {
+		t.Errorf("state = %v, want the other attributes kept", got)
+	}
+}
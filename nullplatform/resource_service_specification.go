@@ -61,8 +61,9 @@ func resourceServiceSpecification() *schema.Resource {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
-					Type:     schema.TypeString,
-					MinItems: 1,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateNRNPattern,
+					MinItems:         1,
 				},
 				Description: "Array representing visibility settings for the service specification",
 			},
@@ -153,7 +154,7 @@ func resourceServiceSpecification() *schema.Resource {
 	}
 }
 
-func CreateServiceSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func CreateServiceSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
 	visibleToRaw := d.Get("visible_to").([]interface{})
@@ -165,19 +166,19 @@ func CreateServiceSpecification(_ context.Context, d *schema.ResourceData, m int
 	dimensionsStr := d.Get("dimensions").(string)
 	var dimensions map[string]interface{}
 	if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 	}
 
 	attributesStr := d.Get("attributes").(string)
 	var attributes map[string]interface{}
 	if err := json.Unmarshal([]byte(attributesStr), &attributes); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 	}
 
 	scopesStr := d.Get("scopes").(string)
 	var scopes map[string]interface{}
 	if err := json.Unmarshal([]byte(scopesStr), &scopes); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
 	}
 
 	selectorsList := d.Get("selectors").([]interface{})
@@ -206,89 +207,92 @@ func CreateServiceSpecification(_ context.Context, d *schema.ResourceData, m int
 		Scopes:            scopes,
 	}
 
-	newSpec, err := nullOps.CreateServiceSpecification(spec)
+	newSpec, err := nullOps.CreateServiceSpecification(ctx, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newSpec.Id)
-	return ReadServiceSpecification(context.Background(), d, m)
+	return ReadServiceSpecification(ctx, d, m)
 }
 
-func ReadServiceSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ReadServiceSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
+	var diags diag.Diagnostics
 	specId := d.Id()
 
-	spec, err := nullOps.GetServiceSpecification(specId)
+	spec, err := nullOps.GetServiceSpecification(ctx, specId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", spec.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("description", spec.Description); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("slug", spec.Slug); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Best-effort: a spec always has at least one snapshot after create, but
 	// don't fail the read if the lookup hiccups — leave it empty.
-	if snapshotID, snapErr := nullOps.GetLatestSnapshotID("service_specification", specId); snapErr == nil {
+	if snapshotID, snapErr := nullOps.GetLatestSnapshotID(ctx, "service_specification", specId); snapErr == nil {
 		if err := d.Set("last_snapshot_id", snapshotID); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	// Best-effort: expose the default-created action specs for package pinning.
-	if actions, actErr := nullOps.ListActionSpecifications(specId); actErr == nil {
-		if err := d.Set("action_specifications", actionSpecsToComputedList(nullOps, actions)); err != nil {
-			return diag.FromErr(err)
+	if actions, actErr := nullOps.ListActionSpecifications(ctx, specId); actErr == nil {
+		actionSpecs, actionDiags := actionSpecsToComputedList(ctx, nullOps, actions)
+		diags = append(diags, actionDiags...)
+		if err := d.Set("action_specifications", actionSpecs); err != nil {
+			return diagFromErr(err)
 		}
 	}
 	if err := d.Set("visible_to", spec.VisibleTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if spec.UseDefaultActions != nil {
 		if err := d.Set("use_default_actions", *spec.UseDefaultActions); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	if spec.UseDefaultNaming != nil {
 		if err := d.Set("use_default_naming", *spec.UseDefaultNaming); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	dimensionsJSON, err := json.Marshal(spec.Dimensions)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing dimensions to JSON: %v", err))
 	}
 	if err := d.Set("dimensions", string(dimensionsJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("assignable_to", spec.AssignableTo); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("type", spec.Type); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	attributesJSON, err := json.Marshal(spec.Attributes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing attributes to JSON: %v", err))
 	}
 	if err := d.Set("attributes", string(attributesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	scopesJSON, err := json.Marshal(spec.Scopes)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing scopes to JSON: %v", err))
 	}
 	if err := d.Set("scopes", string(scopesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	selectors := []map[string]interface{}{
@@ -300,10 +304,10 @@ func ReadServiceSpecification(_ context.Context, d *schema.ResourceData, m inter
 		},
 	}
 	if err := d.Set("selectors", selectors); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func UpdateServiceSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -341,7 +345,7 @@ func UpdateServiceSpecification(ctx context.Context, d *schema.ResourceData, m i
 		dimensionsStr := d.Get("dimensions").(string)
 		var dimensions map[string]interface{}
 		if err := json.Unmarshal([]byte(dimensionsStr), &dimensions); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing dimensions JSON: %v", err))
 		}
 		spec.Dimensions = dimensions
 	}
@@ -358,7 +362,7 @@ func UpdateServiceSpecification(ctx context.Context, d *schema.ResourceData, m i
 		attributesStr := d.Get("attributes").(string)
 		var attributes map[string]interface{}
 		if err := json.Unmarshal([]byte(attributesStr), &attributes); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing attributes JSON: %v", err))
 		}
 		spec.Attributes = attributes
 	}
@@ -367,7 +371,7 @@ func UpdateServiceSpecification(ctx context.Context, d *schema.ResourceData, m i
 		scopesStr := d.Get("scopes").(string)
 		var scopes map[string]interface{}
 		if err := json.Unmarshal([]byte(scopesStr), &scopes); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing scopes JSON: %v", err))
 		}
 		spec.Scopes = scopes
 	}
@@ -385,21 +389,21 @@ func UpdateServiceSpecification(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
-	err := nullOps.PatchServiceSpecification(specId, spec)
+	err := nullOps.PatchServiceSpecification(ctx, specId, spec)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return ReadServiceSpecification(ctx, d, m)
 }
 
-func DeleteServiceSpecification(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func DeleteServiceSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 	specId := d.Id()
 
-	err := nullOps.DeleteServiceSpecification(specId)
+	err := nullOps.DeleteServiceSpecification(ctx, specId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,380 @@
+package nullplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// serviceActionsTestServer fakes a service whose specification has an
+// update action with an integer "size" parameter.
+type serviceActionsTestServer struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	actionStatus  string
+	failPatches   int
+	patches       []map[string]interface{}
+	actionsPosted []ActionInstance
+}
+
+func newServiceActionsTestServer(t *testing.T, actionStatus string, withUpdateAction bool) *serviceActionsTestServer {
+	t.Helper()
+	s := &serviceActionsTestServer{actionStatus: actionStatus}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/service_specification/spec-1/action_specification":
+			results := []map[string]interface{}{{"id": "create-spec", "type": "create"}}
+			if withUpdateAction {
+				results = append(results, map[string]interface{}{
+					"id":   "update-spec",
+					"type": "update",
+					"parameters": map[string]interface{}{
+						"schema": map[string]interface{}{
+							"properties": map[string]interface{}{"size": map[string]interface{}{"type": "integer"}},
+						},
+					},
+					"results": map[string]interface{}{
+						"schema": map[string]interface{}{
+							"properties": map[string]interface{}{
+								"port":     map[string]interface{}{"type": "integer"},
+								"password": map[string]interface{}{"type": "string", "secret": true},
+							},
+						},
+					},
+				})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+		case r.URL.Path == "/service" && r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(Service{Id: "svc-1", SpecificationId: "spec-1", Status: "pending"})
+		case r.URL.Path == "/service/svc-1" && r.Method == http.MethodPatch:
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			s.patches = append(s.patches, body)
+			if s.failPatches > 0 && len(s.patches) > 1 && len(s.patches) <= 1+s.failPatches {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/service/svc-1/action" && r.Method == http.MethodPost:
+			var body ActionInstance
+			json.NewDecoder(r.Body).Decode(&body)
+			s.actionsPosted = append(s.actionsPosted, body)
+			json.NewEncoder(w).Encode(ActionInstance{Id: "act-1", Status: "pending"})
+		case r.URL.Path == "/service/svc-1/action/act-1":
+			json.NewEncoder(w).Encode(ActionInstance{
+				Id:       "act-1",
+				Status:   s.actionStatus,
+				Results:  map[string]interface{}{"port": float64(6379), "password": "hunter2"},
+				Messages: []interface{}{map[string]interface{}{"severity": "error", "message": "quota exceeded"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+// serviceUpdateData returns the data of an action-driven service whose size
+// attribute changes from 1 to 2.
+func serviceUpdateData(t *testing.T, client *NullClient) *schema.ResourceData {
+	t.Helper()
+	r := resourceService()
+	state := &terraform.InstanceState{
+		ID: "svc-1",
+		Attributes: map[string]string{
+			"id":               "svc-1",
+			"name":             "db",
+			"specification_id": "spec-1",
+			"entity_nrn":       "organization=1",
+			"import":           "false",
+			"force_destroy":    "false",
+			"status":           "active",
+			"attributes.%":     "1",
+			"attributes.size":  "1",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "db",
+		"specification_id": "spec-1",
+		"entity_nrn":       "organization=1",
+		"import":           false,
+		"attributes":       map[string]interface{}{"size": "2"},
+	})
+	diff, err := r.SimpleDiff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func shortenActionPolling(t *testing.T) {
+	t.Helper()
+	previous := actionPollInterval
+	actionPollInterval = time.Millisecond
+	t.Cleanup(func() { actionPollInterval = previous })
+}
+
+func TestServiceCreate_KeepsServicePendingWhenCreateActionIsInterrupted(t *testing.T) {
+	shortenActionPolling(t)
+	previous := actionCancelTimeout
+	actionCancelTimeout = 10 * time.Millisecond
+	t.Cleanup(func() { actionCancelTimeout = previous })
+
+	server := newServiceActionsTestServer(t, "in_progress", false)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := schema.TestResourceDataRaw(t, resourceService().Schema, map[string]interface{}{
+		"name":             "db",
+		"specification_id": "spec-1",
+		"entity_nrn":       "organization=1",
+		"import":           false,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	diags := ServiceCreateContext(ctx, d, client)
+	if diags.HasError() {
+		t.Fatalf("diags = %v, want only a warning so the service is not tainted", diags)
+	}
+	if len(diags) != 1 || diags[0].Summary != "Create Action Interrupted" {
+		t.Errorf("diags = %v, want the interruption reported as a warning", diags)
+	}
+	if d.Id() != "svc-1" || d.Get("status") != "pending" {
+		t.Errorf("id = %q and status = %q, want svc-1 kept pending", d.Id(), d.Get("status"))
+	}
+}
+
+func TestServiceUpdate_FinishesInterruptedCreate(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsTestServer(t, "success", false)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	r := resourceService()
+	state := &terraform.InstanceState{
+		ID: "svc-1",
+		Attributes: map[string]string{
+			"id":               "svc-1",
+			"name":             "db",
+			"specification_id": "spec-1",
+			"entity_nrn":       "organization=1",
+			"import":           "false",
+			"force_destroy":    "false",
+			"status":           "pending",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "db",
+		"specification_id": "spec-1",
+		"entity_nrn":       "organization=1",
+		"import":           false,
+	})
+	diff, err := r.SimpleDiff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := ServiceUpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(server.actionsPosted) != 1 || server.actionsPosted[0].SpecificationId != "create-spec" {
+		t.Errorf("posted %+v, want the create action run again", server.actionsPosted)
+	}
+	if len(server.patches) != 0 {
+		t.Errorf("patches = %v, want the status left to the create action", server.patches)
+	}
+}
+
+func TestServiceUpdate_RunsUpdateAction(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsTestServer(t, "success", true)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceUpdateData(t, client)
+	if diags := ServiceUpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if len(server.actionsPosted) != 1 {
+		t.Fatalf("posted %d actions, want 1", len(server.actionsPosted))
+	}
+	action := server.actionsPosted[0]
+	if action.SpecificationId != "update-spec" || action.Parameters["size"] != float64(2) {
+		t.Errorf("posted action %+v, want the update action with size 2", action)
+	}
+	if len(server.patches) != 1 || server.patches[0]["attributes"].(map[string]interface{})["size"] != "2" {
+		t.Errorf("patches = %v, want the new attributes saved once", server.patches)
+	}
+	if got := d.Get("results").(map[string]interface{}); len(got) != 1 || got["port"] != "6379" {
+		t.Errorf("results = %v, want the action's port", got)
+	}
+	if got := d.Get("sensitive_results").(map[string]interface{}); len(got) != 1 || got["password"] != "hunter2" {
+		t.Errorf("sensitive_results = %v, want the action's password", got)
+	}
+}
+
+func TestServiceUpdate_RestoresAttributesWhenActionFails(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsTestServer(t, "failed", true)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceUpdateData(t, client)
+	diags := ServiceUpdateContext(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected the failed action to be reported")
+	}
+	if !strings.Contains(diags[0].Detail, "quota exceeded") || !strings.Contains(diags[0].Detail, "previous attributes were restored") {
+		t.Errorf("detail = %q", diags[0].Detail)
+	}
+
+	if len(server.patches) != 2 {
+		t.Fatalf("patches = %v, want the update and the restore", server.patches)
+	}
+	if got := server.patches[1]["attributes"].(map[string]interface{})["size"]; got != "1" {
+		t.Errorf("restored size = %v, want 1", got)
+	}
+}
+
+func TestServiceUpdate_MarksFailedWhenRestoreFails(t *testing.T) {
+	shortenActionPolling(t)
+	server := newServiceActionsTestServer(t, "failed", true)
+	server.failPatches = 1
+	defer server.Close()
+	client := newTestClient(server.Server)
+	client.RetryPolicy.MaxAttempts = 1
+
+	d := serviceUpdateData(t, client)
+	diags := ServiceUpdateContext(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "marked as failed") {
+		t.Fatalf("diags = %v, want the service reported as marked failed", diags)
+	}
+
+	last := server.patches[len(server.patches)-1]
+	if last["status"] != "failed" {
+		t.Errorf("last patch = %v, want status failed", last)
+	}
+}
+
+func TestServiceUpdate_WarnsWithoutUpdateAction(t *testing.T) {
+	server := newServiceActionsTestServer(t, "success", false)
+	defer server.Close()
+	client := newTestClient(server.Server)
+
+	d := serviceUpdateData(t, client)
+	diags := ServiceUpdateContext(context.Background(), d, client)
+	if diags.HasError() || len(diags) != 1 || diags[0].Summary != "No Update Action" {
+		t.Fatalf("diags = %v, want a single No Update Action warning", diags)
+	}
+	if len(server.actionsPosted) != 0 || len(server.patches) != 1 {
+		t.Errorf("posted %d actions and %d patches, want only the patch", len(server.actionsPosted), len(server.patches))
+	}
+}
+
+func TestServiceRead_ResultsOfLatestCreateOrUpdateAction(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/svc-1":
+			json.NewEncoder(w).Encode(Service{Id: "svc-1", SpecificationId: "spec-1", Selectors: &Selectors{}})
+		case "/service/svc-1/action":
+			json.NewEncoder(w).Encode(map[string]interface{}{"results": []ActionInstance{
+				{Id: "act-1", Status: "success", SpecificationId: "create-spec", CreatedAt: "2026-01-01T00:00:00Z", Results: map[string]interface{}{"port": float64(1)}},
+				{Id: "act-3", Status: "success", SpecificationId: "custom-spec", CreatedAt: "2026-01-03T00:00:00Z", Results: map[string]interface{}{"port": float64(3)}},
+				{Id: "act-2", Status: "success", SpecificationId: "update-spec", CreatedAt: "2026-01-02T00:00:00.5Z", Results: map[string]interface{}{"port": float64(2), "password": "hunter2"}},
+				{Id: "act-4", Status: "failed", SpecificationId: "update-spec", CreatedAt: "2026-01-04T00:00:00Z", Results: map[string]interface{}{"port": float64(4)}},
+				// Earlier than act-2, although it sorts after it as a string.
+				{Id: "act-5", Status: "success", SpecificationId: "create-spec", CreatedAt: "2026-01-02T00:00:00Z", Results: map[string]interface{}{"port": float64(5)}},
+			}})
+		case "/service_specification/spec-1/action_specification":
+			json.NewEncoder(w).Encode(map[string]interface{}{"results": []map[string]interface{}{
+				{"id": "create-spec", "type": "create"},
+				{"id": "custom-spec", "type": "custom"},
+				{"id": "update-spec", "type": "update", "results": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"properties": map[string]interface{}{"password": map[string]interface{}{"type": "string", "secret": true}},
+					},
+				}},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	d := resourceService().TestResourceData()
+	d.SetId("svc-1")
+	d.Set("import", false)
+	if diags := ServiceReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	if got := d.Get("results").(map[string]interface{}); len(got) != 1 || got["port"] != "2" {
+		t.Errorf("results = %v, want those of the latest successful update", got)
+	}
+	if got := d.Get("sensitive_results").(map[string]interface{}); len(got) != 1 || got["password"] != "hunter2" {
+		t.Errorf("sensitive_results = %v, want the password", got)
+	}
+}
+
+func TestServiceRead_Results(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		importMode   bool
+		wantListed   bool
+		wantWarnings int
+	}{
+		{name: "not read for imported services", importMode: true},
+		{name: "listing failure is a warning", wantListed: true, wantWarnings: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			listed := false
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/service/svc-1":
+					json.NewEncoder(w).Encode(Service{Id: "svc-1", SpecificationId: "spec-1", Selectors: &Selectors{}})
+				case "/service/svc-1/action":
+					listed = true
+					w.WriteHeader(http.StatusForbidden)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			d := resourceService().TestResourceData()
+			d.SetId("svc-1")
+			d.Set("import", tc.importMode)
+			diags := ServiceReadContext(context.Background(), d, newTestClient(server))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if listed != tc.wantListed || len(diags) != tc.wantWarnings {
+				t.Errorf("listed actions %v with diags %v, want listed %v and %d warnings", listed, diags, tc.wantListed, tc.wantWarnings)
+			}
+		})
+	}
+}
@@ -110,21 +110,21 @@ func TechnologyTemplateCreate(ctx context.Context, d *schema.ResourceData, m int
 	nullOps := m.(NullOps)
 
 	client := nullOps.(*NullClient)
-	organizationID, err := client.GetOrganizationIDFromToken()
+	organizationID, err := client.GetOrganizationID(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting organization ID from token: %v", err))
+		return diagFromErr(fmt.Errorf("error getting organization ID: %v", err))
 	}
 
 	metadataStr := d.Get("metadata").(string)
 	var metadata map[string]interface{}
 	if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing metadata JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing metadata JSON: %v", err))
 	}
 
 	rulesStr := d.Get("rules").(string)
 	var rules map[string]interface{}
 	if err := json.Unmarshal([]byte(rulesStr), &rules); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing rules JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error parsing rules JSON: %v", err))
 	}
 
 	componentsRaw := d.Get("components").([]interface{})
@@ -134,7 +134,7 @@ func TechnologyTemplateCreate(ctx context.Context, d *schema.ResourceData, m int
 		componentMetadata := map[string]interface{}{}
 		if metadataStr, ok := comp["metadata"].(string); ok && metadataStr != "" {
 			if err := json.Unmarshal([]byte(metadataStr), &componentMetadata); err != nil {
-				return diag.FromErr(fmt.Errorf("error parsing component metadata JSON: %v", err))
+				return diagFromErr(fmt.Errorf("error parsing component metadata JSON: %v", err))
 			}
 		}
 
@@ -165,9 +165,9 @@ func TechnologyTemplateCreate(ctx context.Context, d *schema.ResourceData, m int
 		Rules:        rules,
 	}
 
-	newTemplate, err := nullOps.CreateTechnologyTemplate(template)
+	newTemplate, err := nullOps.CreateTechnologyTemplate(ctx, template)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newTemplate.GetId())
@@ -179,32 +179,32 @@ func TechnologyTemplateRead(ctx context.Context, d *schema.ResourceData, m inter
 	nullOps := m.(NullOps)
 	templateId := d.Id()
 
-	template, err := nullOps.GetTechnologyTemplate(templateId)
+	template, err := nullOps.GetTechnologyTemplate(ctx, templateId)
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("name", template.Name); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("url", template.URL); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("account", template.GetAccount()); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("provider_config", template.Provider); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("tags", template.Tags); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	components := make([]map[string]interface{}, len(template.Components))
 	for i, comp := range template.Components {
 		metadata, err := json.Marshal(comp["metadata"])
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error serializing component metadata to JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error serializing component metadata to JSON: %v", err))
 		}
 
 		components[i] = map[string]interface{}{
@@ -215,23 +215,23 @@ func TechnologyTemplateRead(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 	if err := d.Set("components", components); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	metadataJSON, err := json.Marshal(template.Metadata)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing metadata to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing metadata to JSON: %v", err))
 	}
 	if err := d.Set("metadata", string(metadataJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	rulesJSON, err := json.Marshal(template.Rules)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error serializing rules to JSON: %v", err))
+		return diagFromErr(fmt.Errorf("error serializing rules to JSON: %v", err))
 	}
 	if err := d.Set("rules", string(rulesJSON)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -263,7 +263,7 @@ func TechnologyTemplateUpdate(ctx context.Context, d *schema.ResourceData, m int
 			componentMetadata := map[string]interface{}{}
 			if metadataStr, ok := comp["metadata"].(string); ok && metadataStr != "" {
 				if err := json.Unmarshal([]byte(metadataStr), &componentMetadata); err != nil {
-					return diag.FromErr(fmt.Errorf("error parsing component metadata JSON: %v", err))
+					return diagFromErr(fmt.Errorf("error parsing component metadata JSON: %v", err))
 				}
 			}
 
@@ -290,7 +290,7 @@ func TechnologyTemplateUpdate(ctx context.Context, d *schema.ResourceData, m int
 		metadataStr := d.Get("metadata").(string)
 		var metadata map[string]interface{}
 		if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing metadata JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing metadata JSON: %v", err))
 		}
 		template.Metadata = metadata
 	}
@@ -299,14 +299,14 @@ func TechnologyTemplateUpdate(ctx context.Context, d *schema.ResourceData, m int
 		rulesStr := d.Get("rules").(string)
 		var rules map[string]interface{}
 		if err := json.Unmarshal([]byte(rulesStr), &rules); err != nil {
-			return diag.FromErr(fmt.Errorf("error parsing rules JSON: %v", err))
+			return diagFromErr(fmt.Errorf("error parsing rules JSON: %v", err))
 		}
 		template.Rules = rules
 	}
 
-	err := nullOps.PatchTechnologyTemplate(templateId, template)
+	err := nullOps.PatchTechnologyTemplate(ctx, templateId, template)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return TechnologyTemplateRead(ctx, d, m)
@@ -316,9 +316,9 @@ func TechnologyTemplateDelete(ctx context.Context, d *schema.ResourceData, m int
 	nullOps := m.(NullOps)
 	templateId := d.Id()
 
-	err := nullOps.DeleteTechnologyTemplate(templateId)
+	err := nullOps.DeleteTechnologyTemplate(ctx, templateId)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete technology template with ID '%s': %w", templateId, err))
+		return diagFromErr(fmt.Errorf("failed to delete technology template with ID '%s': %w", templateId, err))
 	}
 
 	d.SetId("")
@@ -62,17 +62,17 @@ func resourceUser() *schema.Resource {
 	}
 }
 
-func CreateUser(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func CreateUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	orgIDStr, err := nullOps.GetOrganizationIDFromToken()
+	orgIDStr, err := nullOps.GetOrganizationID(ctx)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting organization ID: %v", err))
+		return diagFromErr(fmt.Errorf("error getting organization ID: %v", err))
 	}
 
 	orgID, err := strconv.Atoi(orgIDStr)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error converting organization ID to integer: %v", err))
+		return diagFromErr(fmt.Errorf("error converting organization ID to integer: %v", err))
 	}
 
 	newUser := &User{
@@ -83,14 +83,14 @@ func CreateUser(_ context.Context, d *schema.ResourceData, m interface{}) diag.D
 		OrganizationID: orgID,
 	}
 
-	user, err := nullOps.CreateUser(newUser)
+	user, err := nullOps.CreateUser(ctx, newUser)
 	var id int
 	if rErr, ok := IsResourceExistsError(err); ok && !d.Get("strict").(bool) {
 		if rErr.ID == 0 {
-			user, err = nullOps.LookupUser(newUser)
+			user, err = nullOps.LookupUser(ctx, newUser)
 
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			id = user.ID
@@ -100,35 +100,35 @@ func CreateUser(_ context.Context, d *schema.ResourceData, m interface{}) diag.D
 	} else if err == nil {
 		id = user.ID
 	} else {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(id))
-	return ReadUser(context.Background(), d, m)
+	return ReadUser(ctx, d, m)
 }
 
-func ReadUser(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func ReadUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	user, err := nullOps.GetUser(d.Id())
+	user, err := nullOps.GetUser(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return removeIfNotFound(d, err)
 	}
 
 	if err := d.Set("email", user.Email); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("first_name", user.FirstName); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("last_name", user.LastName); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("avatar", user.Avatar); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if err := d.Set("organization_id", user.OrganizationID); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -143,20 +143,20 @@ func UpdateUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 		Avatar:    d.Get("avatar").(string),
 	}
 
-	err := nullOps.UpdateUser(d.Id(), updateUser)
+	err := nullOps.UpdateUser(ctx, d.Id(), updateUser)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return ReadUser(ctx, d, m)
 }
 
-func DeleteUser(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func DeleteUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nullOps := m.(NullOps)
 
-	err := nullOps.DeleteUser(d.Id())
+	err := nullOps.DeleteUser(ctx, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
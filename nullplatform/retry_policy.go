@@ -0,0 +1,144 @@
+package nullplatform
+
+import (
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 250 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+var defaultRetryableStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusConflict,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls how MakeRequest retries failed calls. Idempotent
+// methods are retried on any of RetryableStatusCodes; POST and PATCH are only
+// retried when the request never reached the API or the status proves it was
+// not acted upon (see isSafeToRetry).
+type RetryPolicy struct {
+	MaxAttempts          int
+	MinBackoff           time.Duration
+	MaxBackoff           time.Duration
+	RetryableStatusCodes []int
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          defaultRetryMaxAttempts,
+		MinBackoff:           defaultRetryMinBackoff,
+		MaxBackoff:           defaultRetryMaxBackoff,
+		RetryableStatusCodes: defaultRetryableStatusCodes,
+	}
+}
+
+func (p *RetryPolicy) isRetryableStatusCode(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldRetry decides whether a finished attempt is worth repeating. res and
+// err are the outcome of http.Client.Do.
+func (p *RetryPolicy) shouldRetry(method string, res *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotentMethod(method) || isConnectionError(err)
+	}
+
+	if !p.isRetryableStatusCode(res.StatusCode) {
+		return false
+	}
+
+	return isIdempotentMethod(method) || serverDidNotAct(res.StatusCode)
+}
+
+// backoff returns how long to wait before the given retry (1 for the first
+// retry). It grows exponentially from MinBackoff, is capped at MaxBackoff and
+// uses equal jitter so concurrent resources do not retry in lockstep. A
+// Retry-After header on the response takes precedence, still capped at
+// MaxBackoff so a misbehaving header cannot stall an apply.
+func (p *RetryPolicy) backoff(retry int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, p.MaxBackoff)
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < retry && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, p.MaxBackoff)
+
+	if half := wait / 2; half > 0 {
+		wait = half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+	return wait
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay-seconds and an
+// HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// serverDidNotAct reports statuses that guarantee a mutation was rejected
+// before being applied, so replaying it cannot create duplicates.
+func serverDidNotAct(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// isConnectionError reports failures that happened while establishing the
+// connection (DNS resolution, dial), i.e. before any byte of the request was
+// sent.
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial"
+	}
+
+	return false
+}
@@ -0,0 +1,116 @@
+package nullplatform
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		retry   int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{retry: 1, wantMin: 50 * time.Millisecond, wantMax: 100 * time.Millisecond},
+		{retry: 2, wantMin: 100 * time.Millisecond, wantMax: 200 * time.Millisecond},
+		{retry: 3, wantMin: 200 * time.Millisecond, wantMax: 400 * time.Millisecond},
+		{retry: 10, wantMin: 500 * time.Millisecond, wantMax: time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 50; i++ {
+			got := policy.backoff(tt.retry, nil)
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Fatalf("backoff(%d) = %s, want within [%s, %s]", tt.retry, got, tt.wantMin, tt.wantMax)
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_BackoffPrefersRetryAfter(t *testing.T) {
+	policy := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: 10 * time.Second}
+
+	tests := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{"delay seconds", "3", 3 * time.Second},
+		{"capped at max backoff", "120", 10 * time.Second},
+		{"date in the past", "Mon, 02 Jan 2006 15:04:05 GMT", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{"Retry-After": []string{tt.retryAfter}}}
+			if got := policy.backoff(1, res); got != tt.want {
+				t.Errorf("backoff = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+
+	tests := []struct {
+		value  string
+		wantOk bool
+	}{
+		{"", false},
+		{"0", true},
+		{"15", true},
+		{"-1", false},
+		{"soon", false},
+		{future, true},
+	}
+
+	for _, tt := range tests {
+		if _, ok := parseRetryAfter(tt.value); ok != tt.wantOk {
+			t.Errorf("parseRetryAfter(%q) ok = %v, want %v", tt.value, ok, tt.wantOk)
+		}
+	}
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{"GET on 502", http.MethodGet, http.StatusBadGateway, nil, true},
+		{"GET on 500", http.MethodGet, http.StatusInternalServerError, nil, false},
+		{"GET on read error", http.MethodGet, 0, readErr, true},
+		{"PUT on 504", http.MethodPut, http.StatusGatewayTimeout, nil, true},
+		{"POST on 429", http.MethodPost, http.StatusTooManyRequests, nil, true},
+		{"POST on 503", http.MethodPost, http.StatusServiceUnavailable, nil, true},
+		{"POST on 504", http.MethodPost, http.StatusGatewayTimeout, nil, false},
+		{"POST on 409", http.MethodPost, http.StatusConflict, nil, false},
+		{"POST on dial error", http.MethodPost, 0, dialErr, true},
+		{"POST on read error", http.MethodPost, 0, readErr, false},
+		{"PATCH on DNS error", http.MethodPatch, 0, &net.DNSError{Err: "no such host"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res *http.Response
+			if tt.err == nil {
+				res = &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			}
+			if got := policy.shouldRetry(tt.method, res, tt.err); got != tt.want {
+				t.Errorf("shouldRetry = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
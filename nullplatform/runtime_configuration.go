@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const RUNTIME_CONFIG_PATH = "/runtime_configuration"
@@ -20,7 +20,7 @@ type RuntimeConfigurationValues struct {
 	AWS map[string]string `json:"aws,omitempty"`
 }
 
-func (c *NullClient) CreateRuntimeConfiguration(rc *RuntimeConfiguration) (*RuntimeConfiguration, error) {
+func (c *NullClient) CreateRuntimeConfiguration(ctx context.Context, rc *RuntimeConfiguration) (*RuntimeConfiguration, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*rc)
 
@@ -28,21 +28,14 @@ func (c *NullClient) CreateRuntimeConfiguration(rc *RuntimeConfiguration) (*Runt
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", RUNTIME_CONFIG_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", RUNTIME_CONFIG_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusBadRequest {
-			nErr := &NullErrors{}
-			dErr := json.NewDecoder(res.Body).Decode(nErr)
-			if dErr != nil {
-				return nil, fmt.Errorf("the error is %s", dErr)
-			}
-		}
-		return nil, fmt.Errorf("error creating runtime configuration resource, got status code: %d", res.StatusCode)
+	if err := checkResponse(res, "runtime configuration", "create"); err != nil {
+		return nil, err
 	}
 
 	rcRes := &RuntimeConfiguration{}
@@ -55,7 +48,7 @@ func (c *NullClient) CreateRuntimeConfiguration(rc *RuntimeConfiguration) (*Runt
 	return rcRes, nil
 }
 
-func (c *NullClient) PatchRuntimeConfiguration(runtimeConfigId string, rc *RuntimeConfiguration) error {
+func (c *NullClient) PatchRuntimeConfiguration(ctx context.Context, runtimeConfigId string, rc *RuntimeConfiguration) error {
 	path := fmt.Sprintf("%s/%s", RUNTIME_CONFIG_PATH, runtimeConfigId)
 
 	var buf bytes.Buffer
@@ -65,28 +58,28 @@ func (c *NullClient) PatchRuntimeConfiguration(runtimeConfigId string, rc *Runti
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error patching runtime configuration resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "runtime configuration", "update")
 }
 
-func (c *NullClient) GetRuntimeConfiguration(runtimeConfigId string) (*RuntimeConfiguration, error) {
+func (c *NullClient) GetRuntimeConfiguration(ctx context.Context, runtimeConfigId string) (*RuntimeConfiguration, error) {
 	path := fmt.Sprintf("%s/%s", RUNTIME_CONFIG_PATH, runtimeConfigId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "runtime configuration", "get"); err != nil {
+		return nil, err
+	}
+
 	rc := &RuntimeConfiguration{}
 	derr := json.NewDecoder(res.Body).Decode(rc)
 
@@ -94,25 +87,17 @@ func (c *NullClient) GetRuntimeConfiguration(runtimeConfigId string) (*RuntimeCo
 		return nil, derr
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting runtime configuration resource, got %d for %s", res.StatusCode, runtimeConfigId)
-	}
-
 	return rc, nil
 }
 
-func (c *NullClient) DeleteRuntimeConfiguration(runtimeConfigId string) error {
+func (c *NullClient) DeleteRuntimeConfiguration(ctx context.Context, runtimeConfigId string) error {
 	path := fmt.Sprintf("%s/%s", RUNTIME_CONFIG_PATH, runtimeConfigId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		return fmt.Errorf("error deleting runtime configuration resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "runtime configuration", "delete")
 }
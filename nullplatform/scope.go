@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const SCOPE_PATH = "/scope"
@@ -45,36 +44,36 @@ type Scope struct {
 	RuntimeConfigurations []int             `json:"runtime_configurations,omitempty"`
 }
 
-func (c *NullClient) CreateScope(s *Scope) (*Scope, error) {
+func (c *NullClient) CreateScope(ctx context.Context, s *Scope) (created *Scope, adoptedAfter error, err error) {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(*s)
+	err = json.NewEncoder(&buf).Encode(*s)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode scope: %v", err)
+		return nil, nil, fmt.Errorf("failed to encode scope: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", SCOPE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SCOPE_PATH, &buf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make API request: %v", err)
+		err = fmt.Errorf("failed to make API request: %w", err)
+		if isAmbiguousRequestError(err) {
+			return c.reconcileScopeCreate(ctx, s, err)
+		}
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create scope resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
 	sRes := &Scope{}
-	derr := json.NewDecoder(res.Body).Decode(sRes)
-
-	if derr != nil {
-		return nil, fmt.Errorf("failed to decode API response: %v", derr)
+	if err := decodeJSON(res, "scope", "create", sRes); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileScopeCreate(ctx, s, err)
+		}
+		return nil, nil, err
 	}
 
-	return sRes, nil
+	return sRes, nil, nil
 }
 
-func (c *NullClient) PatchScope(scopeId string, s *Scope) error {
+func (c *NullClient) PatchScope(ctx context.Context, scopeId string, s *Scope) error {
 	path := fmt.Sprintf("%s/%s", SCOPE_PATH, scopeId)
 
 	var buf bytes.Buffer
@@ -84,29 +83,28 @@ func (c *NullClient) PatchScope(scopeId string, s *Scope) error {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch scope resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "scope", "update")
 }
 
-func (c *NullClient) GetScope(scopeId string) (*Scope, error) {
+func (c *NullClient) GetScope(ctx context.Context, scopeId string) (*Scope, error) {
 	path := fmt.Sprintf("%s/%s", SCOPE_PATH, scopeId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "scope", "get"); err != nil {
+		return nil, err
+	}
+
 	s := &Scope{}
 	derr := json.NewDecoder(res.Body).Decode(s)
 
@@ -115,30 +113,25 @@ func (c *NullClient) GetScope(scopeId string) (*Scope, error) {
 	}
 
 	if s.Status == "deleted" || s.Status == "deleting" {
-		return s, fmt.Errorf("error getting scope resource, the status is %s", s.Status)
-	}
-
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get scope resource: status code %d, response: %s, scope id: %s", res.StatusCode, string(bodyBytes), scopeId)
+		return s, &ResourceNotFoundError{ApiType: "scope", Message: fmt.Sprintf("the scope is %s", s.Status)}
 	}
 
 	return s, nil
 }
 
-func (c *NullClient) DeleteScope(scopeId string) error {
+func (c *NullClient) DeleteScope(ctx context.Context, scopeId string) error {
 	pScope := &Scope{
 		Status: "deleting",
 	}
 
-	err := c.PatchScope(scopeId, pScope)
+	err := c.PatchScope(ctx, scopeId, pScope)
 	if err != nil {
 		return err
 	}
 
 	pScope.Status = "deleted"
 
-	err = c.PatchScope(scopeId, pScope)
+	err = c.PatchScope(ctx, scopeId, pScope)
 	if err != nil {
 		return err
 	}
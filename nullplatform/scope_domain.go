@@ -2,9 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 const SCOPE_DOMAIN_PATH = "/scope_domain"
@@ -18,24 +18,20 @@ type ScopeDomain struct {
 	Selector map[string]interface{} `json:"selector,omitempty"`
 }
 
-func (c *NullClient) CreateScopeDomain(sd *ScopeDomain) (*ScopeDomain, error) {
+func (c *NullClient) CreateScopeDomain(ctx context.Context, sd *ScopeDomain) (*ScopeDomain, error) {
 	var buf bytes.Buffer
 	if err := json.NewEncoder(&buf).Encode(*sd); err != nil {
 		return nil, err
 	}
 
-	res, err := c.MakeRequest("POST", SCOPE_DOMAIN_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SCOPE_DOMAIN_PATH, &buf)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return nil, fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return nil, fmt.Errorf("error creating scope domain resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
+	if err := checkResponse(res, "scope domain", "create"); err != nil {
+		return nil, err
 	}
 
 	sdRes := &ScopeDomain{}
@@ -46,17 +42,17 @@ func (c *NullClient) CreateScopeDomain(sd *ScopeDomain) (*ScopeDomain, error) {
 	return sdRes, nil
 }
 
-func (c *NullClient) GetScopeDomain(sdId string) (*ScopeDomain, error) {
+func (c *NullClient) GetScopeDomain(ctx context.Context, sdId string) (*ScopeDomain, error) {
 	path := fmt.Sprintf("%s/%s", SCOPE_DOMAIN_PATH, sdId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("error getting scope domain resource, got %d for %s", res.StatusCode, sdId)
+	if err := checkResponse(res, "scope domain", "get"); err != nil {
+		return nil, err
 	}
 
 	sd := &ScopeDomain{}
@@ -67,7 +63,7 @@ func (c *NullClient) GetScopeDomain(sdId string) (*ScopeDomain, error) {
 	return sd, nil
 }
 
-func (c *NullClient) PatchScopeDomain(sdId string, sd *ScopeDomain) error {
+func (c *NullClient) PatchScopeDomain(ctx context.Context, sdId string, sd *ScopeDomain) error {
 	path := fmt.Sprintf("%s/%s", SCOPE_DOMAIN_PATH, sdId)
 
 	var buf bytes.Buffer
@@ -75,38 +71,28 @@ func (c *NullClient) PatchScopeDomain(sdId string, sd *ScopeDomain) error {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode null error response: %w", err)
-		}
-		return fmt.Errorf("error updating scope domain resource, got status code: %d, message: %s", res.StatusCode, nErr.Message)
-	}
-
-	return nil
+	return checkResponse(res, "scope domain", "update")
 }
 
-func (c *NullClient) DeleteScopeDomain(sdId string) error {
+func (c *NullClient) DeleteScopeDomain(ctx context.Context, sdId string) error {
 	path := fmt.Sprintf("%s/%s", SCOPE_DOMAIN_PATH, sdId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "scope domain", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting scope domain, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
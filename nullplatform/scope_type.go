@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -23,7 +22,7 @@ type ScopeType struct {
 	ProviderId   string `json:"provider_id"`
 }
 
-func (c *NullClient) CreateScopeType(s *ScopeType) (*ScopeType, error) {
+func (c *NullClient) CreateScopeType(ctx context.Context, s *ScopeType) (*ScopeType, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*s)
 
@@ -31,15 +30,14 @@ func (c *NullClient) CreateScopeType(s *ScopeType) (*ScopeType, error) {
 		return nil, fmt.Errorf("failed to encode scope type: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", SCOPE_TYPE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SCOPE_TYPE_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create scope type resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "scope type", "create"); err != nil {
+		return nil, err
 	}
 
 	sRes := &ScopeType{}
@@ -52,7 +50,7 @@ func (c *NullClient) CreateScopeType(s *ScopeType) (*ScopeType, error) {
 	return sRes, nil
 }
 
-func (c *NullClient) PatchScopeType(scopeTypeId string, s *ScopeType) error {
+func (c *NullClient) PatchScopeType(ctx context.Context, scopeTypeId string, s *ScopeType) error {
 	path := fmt.Sprintf("%s/%s", SCOPE_TYPE_PATH, scopeTypeId)
 
 	var buf bytes.Buffer
@@ -62,32 +60,26 @@ func (c *NullClient) PatchScopeType(scopeTypeId string, s *ScopeType) error {
 		return fmt.Errorf("failed to encode scope type: %v", err)
 	}
 
-	res, err := c.MakeRequest("PUT", path, &buf)
+	res, err := c.MakeRequest(ctx, "PUT", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch scope type resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "scope type", "update")
 }
 
-func (c *NullClient) GetScopeType(scopeTypeId string) (*ScopeType, error) {
+func (c *NullClient) GetScopeType(ctx context.Context, scopeTypeId string) (*ScopeType, error) {
 	path := fmt.Sprintf("%s/%s", SCOPE_TYPE_PATH, scopeTypeId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get scope type resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "scope type", "get"); err != nil {
+		return nil, err
 	}
 
 	s := &ScopeType{}
@@ -100,18 +92,19 @@ func (c *NullClient) GetScopeType(scopeTypeId string) (*ScopeType, error) {
 	return s, nil
 }
 
-func (c *NullClient) DeleteScopeType(scopeTypeId string) error {
+func (c *NullClient) DeleteScopeType(ctx context.Context, scopeTypeId string) error {
 	path := fmt.Sprintf("%s/%s", SCOPE_TYPE_PATH, scopeTypeId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) && (res.StatusCode != http.StatusNotFound) {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete scope type resource: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "scope type", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
@@ -2,11 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"os"
 )
 
 const SERVICE_PATH = "/service"
@@ -26,36 +24,35 @@ type Service struct {
 	Attributes             map[string]interface{} `json:"attributes,omitempty"`
 }
 
-func (c *NullClient) CreateService(s *Service) (*Service, error) {
+func (c *NullClient) CreateService(ctx context.Context, s *Service) (created *Service, adoptedAfter error, err error) {
 	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(*s)
+	err = json.NewEncoder(&buf).Encode(*s)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	res, err := c.MakeRequest("POST", SERVICE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SERVICE_PATH, &buf)
 	if err != nil {
-		return nil, err
+		if isAmbiguousRequestError(err) {
+			return c.reconcileServiceCreate(ctx, s, err)
+		}
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("error creating service resource: status=%d body=%s", res.StatusCode, string(bodyBytes))
-	}
-
 	sRes := &Service{}
-	derr := json.NewDecoder(res.Body).Decode(sRes)
-
-	if derr != nil {
-		return nil, derr
+	if err := decodeJSON(res, "service", "create", sRes); err != nil {
+		if isAmbiguousCreateError(err) {
+			return c.reconcileServiceCreate(ctx, s, err)
+		}
+		return nil, nil, err
 	}
 
-	return sRes, nil
+	return sRes, nil, nil
 }
 
-func (c *NullClient) PatchService(serviceId string, s *Service) error {
+func (c *NullClient) PatchService(ctx context.Context, serviceId string, s *Service) error {
 	path := fmt.Sprintf("%s/%s", SERVICE_PATH, serviceId)
 
 	var buf bytes.Buffer
@@ -65,49 +62,49 @@ func (c *NullClient) PatchService(serviceId string, s *Service) error {
 		return err
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if (res.StatusCode != http.StatusOK) && (res.StatusCode != http.StatusNoContent) {
-		io.Copy(os.Stdout, res.Body)
-		return fmt.Errorf("error patching service resource, got %d", res.StatusCode)
-	}
-
-	return nil
+	return checkResponse(res, "service", "update")
 }
 
-func (c *NullClient) DeleteService(serviceId string, force bool) error {
+func (c *NullClient) DeleteService(ctx context.Context, serviceId string, force bool) error {
 	path := fmt.Sprintf("%s/%s", SERVICE_PATH, serviceId)
 	if force {
 		path = path + "?force=true"
 	}
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		io.Copy(os.Stdout, res.Body)
-		return fmt.Errorf("error deleting service resource, got %d for %s", res.StatusCode, serviceId)
+	if err := checkResponse(res, "service", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (c *NullClient) GetService(serviceId string) (*Service, error) {
+func (c *NullClient) GetService(ctx context.Context, serviceId string) (*Service, error) {
 	path := fmt.Sprintf("%s/%s", SERVICE_PATH, serviceId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	if err := checkResponse(res, "service", "get"); err != nil {
+		return nil, err
+	}
+
 	s := &Service{}
 	derr := json.NewDecoder(res.Body).Decode(s)
 
@@ -115,10 +112,5 @@ func (c *NullClient) GetService(serviceId string) (*Service, error) {
 		return nil, derr
 	}
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		io.Copy(os.Stdout, res.Body)
-		return nil, fmt.Errorf("error getting service resource, got %d for %s", res.StatusCode, serviceId)
-	}
-
 	return s, nil
 }
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func findActionSpecByType(specs []*ActionSpecification, actionType string) (*ActionSpecification, error) {
@@ -35,25 +38,10 @@ func findActionSpecByType(specs []*ActionSpecification, actionType string) (*Act
 // etc.) and the API will reject mismatches.
 func projectAttributesToParameters(attributes map[string]interface{}, parameterSchema map[string]interface{}) (map[string]interface{}, error) {
 	out := map[string]interface{}{}
-	if parameterSchema == nil || attributes == nil {
-		return out, nil
-	}
-	schemaRaw, ok := parameterSchema["schema"]
-	if !ok {
-		return out, nil
-	}
-	schemaMap, ok := schemaRaw.(map[string]interface{})
-	if !ok {
-		return out, nil
-	}
-	propsRaw, ok := schemaMap["properties"]
-	if !ok {
-		return out, nil
-	}
-	props, ok := propsRaw.(map[string]interface{})
-	if !ok {
+	if attributes == nil {
 		return out, nil
 	}
+	props := schemaProperties(parameterSchema)
 	for key, propRaw := range props {
 		v, present := attributes[key]
 		if !present {
@@ -69,6 +57,98 @@ func projectAttributesToParameters(attributes map[string]interface{}, parameterS
 	return out, nil
 }
 
+// schemaProperties returns the properties of the JSON Schema held by an
+// action specification's `parameters` or `results`, under
+// field["schema"]["properties"], or nil when there are none.
+func schemaProperties(field map[string]interface{}) map[string]interface{} {
+	schemaMap, ok := field["schema"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	props, _ := schemaMap["properties"].(map[string]interface{})
+	return props
+}
+
+// isSecretProperty reports whether a JSON Schema property holds a secret:
+// nullplatform marks them with `secret` or `sensitive`, or an `export` with
+// `secret`, and standard JSON Schema with `writeOnly`.
+func isSecretProperty(propertySchema map[string]interface{}) bool {
+	for _, key := range []string{"secret", "sensitive", "writeOnly"} {
+		if v, _ := propertySchema[key].(bool); v {
+			return true
+		}
+	}
+	export, _ := propertySchema["export"].(map[string]interface{})
+	secret, _ := export["secret"].(bool)
+	return secret
+}
+
+// splitActionResults renders an action's results as Terraform strings,
+// split into the results and the secret results resultsSchema (the action
+// specification's `results` field) declares. Strings are kept as they are,
+// numbers and booleans are formatted like the type their property declares,
+// and objects and arrays are JSON encoded, so that tonumber, tobool and
+// jsondecode read them back. Results the schema does not declare are not
+// secret; null results are left out.
+func splitActionResults(results map[string]interface{}, resultsSchema map[string]interface{}) (map[string]string, map[string]string, error) {
+	props := schemaProperties(resultsSchema)
+	plain, secret := map[string]string{}, map[string]string{}
+	for key, v := range results {
+		if v == nil {
+			continue
+		}
+		propSchema, _ := props[key].(map[string]interface{})
+		rendered, err := renderResult(v, propSchema)
+		if err != nil {
+			return nil, nil, fmt.Errorf("result %q: %w", key, err)
+		}
+		if isSecretProperty(propSchema) {
+			secret[key] = rendered
+		} else {
+			plain[key] = rendered
+		}
+	}
+	return plain, secret, nil
+}
+
+// setActionResults sets the results and sensitive_results attributes of d
+// from a finished action of a specification with resultsSchema.
+func setActionResults(d *schema.ResourceData, action *ActionInstance, resultsSchema map[string]interface{}) error {
+	var results map[string]interface{}
+	if action != nil {
+		results = action.Results
+	}
+	plain, secret, err := splitActionResults(results, resultsSchema)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("results", plain); err != nil {
+		return err
+	}
+	return d.Set("sensitive_results", secret)
+}
+
+func renderResult(v interface{}, propertySchema map[string]interface{}) (string, error) {
+	typeStr, _ := propertySchema["type"].(string)
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		if typeStr == "integer" && v == float64(int64(v)) {
+			return strconv.FormatInt(int64(v), 10), nil
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
+
 // coerceToSchemaType converts a value to the JSON type declared in
 // propertySchema["type"]. If the value is already the right type, it's
 // returned unchanged. Strings are parsed for number/integer/boolean/array/
@@ -144,3 +224,24 @@ func summarizeMessages(messages []interface{}) string {
 	}
 	return "no message"
 }
+
+// formatMessages lists an action's messages, one per line with its severity,
+// or returns "" when it has none.
+func formatMessages(messages []interface{}) string {
+	var lines []string
+	for _, raw := range messages {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		text, ok := m["message"].(string)
+		if !ok {
+			continue
+		}
+		if severity, _ := m["severity"].(string); severity != "" {
+			text = fmt.Sprintf("[%s] %s", severity, text)
+		}
+		lines = append(lines, text)
+	}
+	return strings.Join(lines, "\n")
+}
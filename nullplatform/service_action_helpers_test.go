@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -201,6 +202,65 @@ func TestProjectAttributesToParameters_CoercesStringToArrayAndObject(t *testing.
 	}
 }
 
+func TestSplitActionResults_TypesAndSecrets(t *testing.T) {
+	results := map[string]interface{}{
+		"endpoint": "redis.local",
+		"port":     float64(6379),
+		"ratio":    0.5,
+		"tls":      true,
+		"hosts":    []interface{}{"a", "b"},
+		"password": "hunter2",
+		"token":    "abc",
+		"key":      "xyz",
+		"extra":    float64(1),
+		"missing":  nil,
+	}
+	schema := map[string]interface{}{
+		"schema": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"endpoint": map[string]interface{}{"type": "string"},
+				"port":     map[string]interface{}{"type": "integer"},
+				"ratio":    map[string]interface{}{"type": "number"},
+				"tls":      map[string]interface{}{"type": "boolean"},
+				"hosts":    map[string]interface{}{"type": "array"},
+				"password": map[string]interface{}{"type": "string", "secret": true},
+				"token":    map[string]interface{}{"type": "string", "writeOnly": true},
+				"key":      map[string]interface{}{"type": "string", "export": map[string]interface{}{"type": "environment_variable", "secret": true}},
+			},
+		},
+	}
+	plain, secret, err := splitActionResults(results, schema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantPlain := map[string]string{
+		"endpoint": "redis.local",
+		"port":     "6379",
+		"ratio":    "0.5",
+		"tls":      "true",
+		"hosts":    `["a","b"]`,
+		"extra":    "1",
+	}
+	if !reflect.DeepEqual(plain, wantPlain) {
+		t.Errorf("plain: got %v, want %v", plain, wantPlain)
+	}
+	wantSecret := map[string]string{"password": "hunter2", "token": "abc", "key": "xyz"}
+	if !reflect.DeepEqual(secret, wantSecret) {
+		t.Errorf("secret: got %v, want %v", secret, wantSecret)
+	}
+}
+
+func TestSplitActionResults_NoSchema(t *testing.T) {
+	plain, secret, err := splitActionResults(map[string]interface{}{"port": float64(80)}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plain["port"] != "80" || len(secret) != 0 {
+		t.Errorf("got plain=%v secret=%v, want port in plain", plain, secret)
+	}
+}
+
 func TestSummarizeMessages_PrefersLastErrorSeverity(t *testing.T) {
 	msgs := []interface{}{
 		map[string]interface{}{"severity": "info", "message": "starting"},
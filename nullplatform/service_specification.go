@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -39,22 +38,21 @@ type ServiceSpecification struct {
 	Scopes            map[string]interface{} `json:"scopes,omitempty"`
 }
 
-func (c *NullClient) CreateServiceSpecification(s *ServiceSpecification) (*ServiceSpecification, error) {
+func (c *NullClient) CreateServiceSpecification(ctx context.Context, s *ServiceSpecification) (*ServiceSpecification, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*s)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode service specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", SERVICE_SPECIFICATION_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", SERVICE_SPECIFICATION_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create service specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "service specification", "create"); err != nil {
+		return nil, err
 	}
 
 	sRes := &ServiceSpecification{}
@@ -65,18 +63,17 @@ func (c *NullClient) CreateServiceSpecification(s *ServiceSpecification) (*Servi
 	return sRes, nil
 }
 
-func (c *NullClient) GetServiceSpecification(specId string) (*ServiceSpecification, error) {
+func (c *NullClient) GetServiceSpecification(ctx context.Context, specId string) (*ServiceSpecification, error) {
 	path := fmt.Sprintf("%s/%s", SERVICE_SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get service specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "service specification", "get"); err != nil {
+		return nil, err
 	}
 
 	spec := &ServiceSpecification{}
@@ -87,7 +84,7 @@ func (c *NullClient) GetServiceSpecification(specId string) (*ServiceSpecificati
 	return spec, nil
 }
 
-func (c *NullClient) PatchServiceSpecification(specId string, s *ServiceSpecification) error {
+func (c *NullClient) PatchServiceSpecification(ctx context.Context, specId string, s *ServiceSpecification) error {
 	path := fmt.Sprintf("%s/%s", SERVICE_SPECIFICATION_PATH, specId)
 
 	var buf bytes.Buffer
@@ -96,32 +93,28 @@ func (c *NullClient) PatchServiceSpecification(specId string, s *ServiceSpecific
 		return fmt.Errorf("failed to encode service specification: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch service specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "service specification", "update")
 }
 
-func (c *NullClient) DeleteServiceSpecification(specId string) error {
+func (c *NullClient) DeleteServiceSpecification(ctx context.Context, specId string) error {
 	path := fmt.Sprintf("%s/%s", SERVICE_SPECIFICATION_PATH, specId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to delete service specification: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "service specification", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -26,7 +27,7 @@ func TestDeleteService_ForceQueryParam(t *testing.T) {
 			defer server.Close()
 
 			c := newTestClient(server)
-			if err := c.DeleteService("svc-123", tt.force); err != nil {
+			if err := c.DeleteService(context.Background(), "svc-123", tt.force); err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
 			if !strings.Contains(gotQuery, tt.wantQuery) {
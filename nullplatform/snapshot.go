@@ -1,10 +1,8 @@
 package nullplatform
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 // specSnapshot is one append-only snapshot of a specification. The highest
@@ -15,44 +13,25 @@ type specSnapshot struct {
 	SequenceNumber int    `json:"sequence_number"`
 }
 
-type specSnapshotList struct {
-	Results []specSnapshot `json:"results"`
-}
-
 // GetLatestSnapshotID returns the newest snapshot id for a spec-like resource.
 // kind is the URL segment of the owning service: "service_specification",
 // "action_specification" or "link_specification". Returns "" (no error) when
 // the resource has no snapshots yet, so callers can leave the computed
 // attribute empty rather than failing the read.
-func (c *NullClient) GetLatestSnapshotID(kind, id string) (string, error) {
+func (c *NullClient) GetLatestSnapshotID(ctx context.Context, kind, id string) (string, error) {
 	path := fmt.Sprintf("/%s/%s/snapshots", kind, id)
 
-	res, err := c.MakeRequest("GET", path, nil)
-	if err != nil {
-		return "", fmt.Errorf("error listing %s snapshots: %v", kind, err)
-	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
+	snapshots, err := listAllCached[specSnapshot](ctx, c, path, kind+" snapshots")
 	if err != nil {
-		return "", fmt.Errorf("error reading snapshot list response: %v", err)
-	}
-
-	if res.StatusCode == http.StatusNotFound {
-		return "", nil
-	}
-	if res.StatusCode < 200 || res.StatusCode > 299 {
-		return "", fmt.Errorf("error listing %s snapshots, status code: %d, body: %s", kind, res.StatusCode, string(body))
-	}
-
-	list := &specSnapshotList{}
-	if err := json.Unmarshal(body, list); err != nil {
-		return "", fmt.Errorf("error decoding snapshot list: %v", err)
+		if _, ok := IsResourceNotFoundError(err); ok {
+			return "", nil
+		}
+		return "", err
 	}
 
 	latestID := ""
 	highest := -1
-	for _, snapshot := range list.Results {
+	for _, snapshot := range snapshots {
 		if snapshot.SequenceNumber > highest {
 			highest = snapshot.SequenceNumber
 			latestID = snapshot.ID
@@ -2,8 +2,12 @@ package nullplatform
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"sync"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -35,32 +39,66 @@ func actionSpecificationsComputedSchema() *schema.Schema {
 	}
 }
 
+// snapshotLookupWorkers bounds how many snapshot lookups a single spec read
+// runs at once. The request limiter still applies on top of it.
+const snapshotLookupWorkers = 4
+
 // actionSpecsToComputedList shapes a spec's action specifications into the
 // `action_specifications` computed list, resolving each action's newest
-// snapshot id (best-effort — a missing snapshot yields an empty string rather
-// than failing the read).
+// snapshot id concurrently. A failed lookup leaves that entry's
+// last_snapshot_id empty and comes back as a warning rather than failing the
+// read, so a blank revision never ends up pinned in a package BOM unnoticed.
 //
 // The list is sorted by slug so its order is STABLE across reads and applies.
 // A slug (create-/update-/delete-<spec>) is a stable identity even when the
 // underlying action is re-created with a new id on a spec update, so a package
 // BOM that pins these components as an ordered list keeps a consistent order
 // and Terraform's positional tracking never sees a component "move".
-func actionSpecsToComputedList(nullOps NullOps, actions []*ActionSpecification) []map[string]interface{} {
+func actionSpecsToComputedList(ctx context.Context, nullOps NullOps, actions []*ActionSpecification) ([]map[string]interface{}, diag.Diagnostics) {
 	sorted := make([]*ActionSpecification, len(actions))
 	copy(sorted, actions)
 	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Slug < sorted[j].Slug })
 
+	snapshotIDs := make([]string, len(sorted))
+	errs := make([]error, len(sorted))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(snapshotLookupWorkers, len(sorted)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				snapshotIDs[i], errs[i] = nullOps.GetLatestSnapshotID(ctx, "action_specification", sorted[i].Id)
+			}
+		}()
+	}
+	for i := range sorted {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var diags diag.Diagnostics
 	out := make([]map[string]interface{}, 0, len(sorted))
-	for _, a := range sorted {
-		snapshotID, _ := nullOps.GetLatestSnapshotID("action_specification", a.Id)
+	for i, a := range sorted {
+		if errs[i] != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Could not resolve action specification snapshot",
+				Detail: fmt.Sprintf("Looking up the latest snapshot of action specification %q (%s) failed, so its last_snapshot_id is left empty: %v. "+
+					"A package pinning it would get a blank revision; refresh once the API is reachable.", a.Slug, a.Id, errs[i]),
+				AttributePath: cty.GetAttrPath("action_specifications").IndexInt(i).GetAttr("last_snapshot_id"),
+			})
+		}
 		out = append(out, map[string]interface{}{
 			"id":               a.Id,
 			"name":             a.Name,
 			"slug":             a.Slug,
-			"last_snapshot_id": snapshotID,
+			"last_snapshot_id": snapshotIDs[i],
 		})
 	}
-	return out
+	return out, diags
 }
 
 // specBOMCustomizeDiff forces the computed BOM attributes (last_snapshot_id and
@@ -0,0 +1,79 @@
+package nullplatform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestActionSpecsToComputedList(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		id := strings.Split(strings.Trim(r.URL.Path, "/"), "/")[1]
+		if id == "broken" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"results":[{"id":"snap-` + id + `","sequence_number":1}]}`))
+	}))
+	defer server.Close()
+
+	actions := []*ActionSpecification{
+		{Id: "3", Slug: "update-db"},
+		{Id: "broken", Slug: "delete-db"},
+		{Id: "1", Slug: "create-db"},
+		{Id: "4", Slug: "restart-db"},
+		{Id: "5", Slug: "scale-db"},
+		{Id: "6", Slug: "backup-db"},
+	}
+
+	list, diags := actionSpecsToComputedList(context.Background(), newTestClient(server), actions)
+
+	want := []struct{ slug, snapshot string }{
+		{"backup-db", "snap-6"},
+		{"create-db", "snap-1"},
+		{"delete-db", ""},
+		{"restart-db", "snap-4"},
+		{"scale-db", "snap-5"},
+		{"update-db", "snap-3"},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(list), len(want))
+	}
+	for i, w := range want {
+		if list[i]["slug"] != w.slug || list[i]["last_snapshot_id"] != w.snapshot {
+			t.Errorf("list[%d] = %v, want slug %s with snapshot %q", i, list[i], w.slug, w.snapshot)
+		}
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("diags = %v, want one warning for the failed lookup", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "delete-db") {
+		t.Errorf("warning detail = %q, want it to name the action", diags[0].Detail)
+	}
+	if want := cty.GetAttrPath("action_specifications").IndexInt(2).GetAttr("last_snapshot_id"); !diags[0].AttributePath.Equals(want) {
+		t.Errorf("warning path = %v, want %v", diags[0].AttributePath, want)
+	}
+
+	if got := atomic.LoadInt32(&maxInFlight); got < 2 || got > snapshotLookupWorkers {
+		t.Errorf("ran %d lookups at once, want between 2 and %d", got, snapshotLookupWorkers)
+	}
+}
@@ -1,6 +1,7 @@
 package nullplatform
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -32,7 +33,7 @@ func TestSpecificationDefaultFlagsSerialization(t *testing.T) {
 	} {
 		t.Run("link/"+tt.name, func(t *testing.T) {
 			body := captureRequestBody(t, func(c *NullClient) {
-				if _, err := c.CreateLinkSpecification(&LinkSpecification{
+				if _, err := c.CreateLinkSpecification(context.Background(), &LinkSpecification{
 					Name:              "test",
 					UseDefaultActions: tt.set,
 					UseDefaultNaming:  tt.set,
@@ -46,7 +47,7 @@ func TestSpecificationDefaultFlagsSerialization(t *testing.T) {
 
 		t.Run("service/"+tt.name, func(t *testing.T) {
 			body := captureRequestBody(t, func(c *NullClient) {
-				if _, err := c.CreateServiceSpecification(&ServiceSpecification{
+				if _, err := c.CreateServiceSpecification(context.Background(), &ServiceSpecification{
 					Name:              "test",
 					UseDefaultActions: tt.set,
 					UseDefaultNaming:  tt.set,
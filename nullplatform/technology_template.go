@@ -2,10 +2,9 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 const (
@@ -49,22 +48,21 @@ func (t *TechnologyTemplate) GetAccount() string {
 	return t.Account.String()
 }
 
-func (c *NullClient) CreateTechnologyTemplate(t *TechnologyTemplate) (*TechnologyTemplate, error) {
+func (c *NullClient) CreateTechnologyTemplate(ctx context.Context, t *TechnologyTemplate) (*TechnologyTemplate, error) {
 	var buf bytes.Buffer
 	err := json.NewEncoder(&buf).Encode(*t)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode technology template: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", TECHNOLOGY_TEMPLATE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", TECHNOLOGY_TEMPLATE_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create technology template: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "technology template", "create"); err != nil {
+		return nil, err
 	}
 
 	tRes := &TechnologyTemplate{}
@@ -75,18 +73,17 @@ func (c *NullClient) CreateTechnologyTemplate(t *TechnologyTemplate) (*Technolog
 	return tRes, nil
 }
 
-func (c *NullClient) GetTechnologyTemplate(templateId string) (*TechnologyTemplate, error) {
+func (c *NullClient) GetTechnologyTemplate(ctx context.Context, templateId string) (*TechnologyTemplate, error) {
 	path := fmt.Sprintf("%s/%s", TECHNOLOGY_TEMPLATE_PATH, templateId)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get technology template: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "technology template", "get"); err != nil {
+		return nil, err
 	}
 
 	template := &TechnologyTemplate{}
@@ -97,7 +94,7 @@ func (c *NullClient) GetTechnologyTemplate(templateId string) (*TechnologyTempla
 	return template, nil
 }
 
-func (c *NullClient) PatchTechnologyTemplate(templateId string, t *TechnologyTemplate) error {
+func (c *NullClient) PatchTechnologyTemplate(ctx context.Context, templateId string, t *TechnologyTemplate) error {
 	path := fmt.Sprintf("%s/%s", TECHNOLOGY_TEMPLATE_PATH, templateId)
 
 	var buf bytes.Buffer
@@ -106,35 +103,34 @@ func (c *NullClient) PatchTechnologyTemplate(templateId string, t *TechnologyTem
 		return fmt.Errorf("failed to encode technology template: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to patch technology template: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "technology template", "update"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (c *NullClient) DeleteTechnologyTemplate(templateId string) error {
+func (c *NullClient) DeleteTechnologyTemplate(ctx context.Context, templateId string) error {
 	path := fmt.Sprintf("%s/%s", TECHNOLOGY_TEMPLATE_PATH, templateId)
 
-	res, err := c.MakeRequest("DELETE", path, nil)
+	res, err := c.MakeRequest(ctx, "DELETE", path, nil)
 	if err != nil {
 		return fmt.Errorf("error making DELETE request: %w", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		var nErr NullErrors
-		if err := json.NewDecoder(res.Body).Decode(&nErr); err != nil {
-			return fmt.Errorf("failed to decode error response: %w", err)
+	if err := checkResponse(res, "technology template", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
 		}
-		return fmt.Errorf("error deleting technology template, got status code: %d, message: %s", res.StatusCode, nErr.Message)
 	}
 
 	return nil
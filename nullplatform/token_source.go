@@ -0,0 +1,170 @@
+package nullplatform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource issues access tokens in place of the API key exchange, for
+// setups where Terraform runs with short-lived tokens handed out by an
+// external broker. NullClient asks it for a token before every request and
+// tells it when the API rejected one.
+type TokenSource interface {
+	// Token returns the access token to send. Sources cache the token
+	// themselves and only do real work when it changed or is about to expire.
+	Token(ctx context.Context) (Token, error)
+	// Invalidate forgets the given token after the API answered 401 with it.
+	Invalidate(rejected string)
+}
+
+// staticTokenSource serves a pre-issued access token as is.
+type staticTokenSource struct {
+	token Token
+}
+
+func NewStaticTokenSource(accessToken string) TokenSource {
+	return &staticTokenSource{token: Token{AccessToken: strings.TrimSpace(accessToken)}}
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (Token, error) {
+	if s.token.expiresWithin(0) {
+		return Token{}, fmt.Errorf("the configured access_token has expired, issue a new one")
+	}
+	return s.token, nil
+}
+
+func (s *staticTokenSource) Invalidate(rejected string) {}
+
+// fileTokenSource reads the access token from a file, re-reading it whenever
+// the file is modified so a broker can rotate it while Terraform runs.
+type fileTokenSource struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   Token
+}
+
+func NewFileTokenSource(path string) TokenSource {
+	return &fileTokenSource{path: path}
+}
+
+func (s *fileTokenSource) Token(ctx context.Context) (Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return Token{}, fmt.Errorf("reading token_file: %w", err)
+	}
+
+	if s.token.AccessToken != "" && info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return s.token, nil
+	}
+
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return Token{}, fmt.Errorf("reading token_file: %w", err)
+	}
+	accessToken := strings.TrimSpace(string(content))
+	if accessToken == "" {
+		return Token{}, fmt.Errorf("token_file %s is empty", s.path)
+	}
+
+	log.Printf("[DEBUG] read a new access token from %s", s.path)
+	s.token = Token{AccessToken: accessToken}
+	s.modTime = info.ModTime()
+	s.size = info.Size()
+
+	return s.token, nil
+}
+
+// Invalidate forces the next call to re-read the file even if it did not
+// change; a token the API rejected is only replaced once the file is.
+func (s *fileTokenSource) Invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken == rejected {
+		s.token = Token{}
+	}
+}
+
+// execCredential is what an exec credential helper prints on stdout. Expiry
+// is optional; without it the token's exp claim is used, and a token carrying
+// neither is kept until the API rejects it.
+type execCredential struct {
+	Token  string    `json:"token"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// execTokenSource runs an external command to obtain a token, similar to
+// kubeconfig exec plugins. The command is run again once the token is within
+// tokenRefreshWindow of its expiry.
+type execTokenSource struct {
+	command string
+	args    []string
+
+	mu    sync.Mutex
+	token Token
+}
+
+func NewExecTokenSource(command string, args []string) TokenSource {
+	return &execTokenSource{command: command, args: args}
+}
+
+func (s *execTokenSource) Token(ctx context.Context) (Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != "" && !s.token.expiresWithin(tokenRefreshWindow) {
+		return s.token, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command, s.args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] running %s to obtain an access token", s.command)
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return Token{}, fmt.Errorf("exec credential command %s failed: %w: %s", s.command, err, msg)
+		}
+		return Token{}, fmt.Errorf("exec credential command %s failed: %w", s.command, err)
+	}
+
+	var credential execCredential
+	if err := json.Unmarshal(stdout.Bytes(), &credential); err != nil {
+		return Token{}, fmt.Errorf("exec credential command %s did not print a JSON object with a token: %w", s.command, err)
+	}
+	if credential.Token == "" {
+		return Token{}, fmt.Errorf("exec credential command %s printed no token", s.command)
+	}
+
+	token := Token{AccessToken: credential.Token, Expiry: credential.Expiry}
+	if token.expiresWithin(0) {
+		return Token{}, fmt.Errorf("exec credential command %s returned a token that expired at %s", s.command, credential.Expiry.Format(time.RFC3339))
+	}
+
+	s.token = token
+	return s.token, nil
+}
+
+func (s *execTokenSource) Invalidate(rejected string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken == rejected {
+		s.token = Token{}
+	}
+}
@@ -0,0 +1,146 @@
+package nullplatform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStaticTokenSource_RejectsExpiredToken(t *testing.T) {
+	if _, err := NewStaticTokenSource(signedTestToken(t, time.Now().Add(time.Hour))).Token(context.Background()); err != nil {
+		t.Errorf("Token() error = %v for a valid token", err)
+	}
+	if _, err := NewStaticTokenSource(signedTestToken(t, time.Now().Add(-time.Minute))).Token(context.Background()); err == nil {
+		t.Error("Token() returned an expired access_token")
+	}
+}
+
+func TestFileTokenSource_RereadsTheFileWhenItChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	write := func(content string, modTime time.Time) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	write("first\n", now)
+
+	source := NewFileTokenSource(path)
+	token, err := source.Token(context.Background())
+	if err != nil || token.AccessToken != "first" {
+		t.Fatalf("Token() = %q, %v, want first", token.AccessToken, err)
+	}
+
+	write("second", now.Add(time.Second))
+	token, err = source.Token(context.Background())
+	if err != nil || token.AccessToken != "second" {
+		t.Fatalf("Token() = %q, %v, want the rotated token", token.AccessToken, err)
+	}
+
+	write("", now.Add(2*time.Second))
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Error("Token() accepted an empty token file")
+	}
+}
+
+func TestExecTokenSource(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "runs")
+	// Prints a token that expires within the refresh window, so every call
+	// runs the command again.
+	script := `echo run >> ` + counter + `; echo "{\"token\":\"exec-$(wc -l < ` + counter + ` | tr -d ' ')\",\"expiry\":\"` +
+		time.Now().Add(time.Minute).UTC().Format(time.RFC3339) + `\"}"`
+	source := NewExecTokenSource("sh", []string{"-c", script})
+
+	for _, want := range []string{"exec-1", "exec-2"} {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token.AccessToken != want {
+			t.Errorf("Token() = %q, want %q", token.AccessToken, want)
+		}
+	}
+
+	cached := NewExecTokenSource("sh", []string{"-c", `echo run >> ` + counter + `; echo '{"token":"long-lived","expiry":"` +
+		time.Now().Add(time.Hour).UTC().Format(time.RFC3339) + `"}'`})
+	for i := 0; i < 3; i++ {
+		if _, err := cached.Token(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runs, _ := os.ReadFile(counter)
+	if got := strings.Count(string(runs), "run"); got != 3 {
+		t.Errorf("ran the commands %d times, want 3 (the long-lived token is reused)", got)
+	}
+}
+
+func TestExecTokenSource_Errors(t *testing.T) {
+	tests := map[string]struct {
+		script  string
+		wantErr string
+	}{
+		"failing command": {`echo "broker unreachable" >&2; exit 3`, "broker unreachable"},
+		"not JSON":        {`echo token`, "did not print a JSON object"},
+		"no token":        {`echo '{}'`, "printed no token"},
+		"expired token":   {`echo '{"token":"t","expiry":"2000-01-01T00:00:00Z"}'`, "expired"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewExecTokenSource("sh", []string{"-c", tt.script}).Token(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Token() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMakeRequest_UsesTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("revoked"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var tokenRequests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == TOKEN_PATH {
+			atomic.AddInt32(&tokenRequests, 1)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer rotated" {
+			// The broker rotates the file once it learns the token was revoked.
+			os.WriteFile(path, []byte("rotated"), 0o600)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server)
+	client.Token = Token{}
+	client.TokenSource = NewFileTokenSource(path)
+
+	res, err := client.MakeRequest(context.Background(), http.MethodGet, "/scope/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want the replay with the re-read token to succeed", res.StatusCode)
+	}
+	if got := atomic.LoadInt32(&tokenRequests); got != 0 {
+		t.Errorf("sent %d token requests, want the API key exchange skipped", got)
+	}
+}
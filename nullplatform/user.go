@@ -2,10 +2,10 @@ package nullplatform
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"net/url"
 )
 
 const (
@@ -22,16 +22,6 @@ type User struct {
 	OrganizationID int    `json:"organization_id"`
 }
 
-type ListPaging struct {
-	Offset int `json:"offset"`
-	Limit  int `json:"limit"`
-}
-
-type UserList struct {
-	Paging  ListPaging `json:"paging"`
-	Results []User     `json:"results"`
-}
-
 type createUserRequest struct {
 	Email          string        `json:"email"`
 	FirstName      string        `json:"first_name"`
@@ -45,7 +35,7 @@ type deactivateUserRequest struct {
 	Status string `json:"status"`
 }
 
-func (c *NullClient) CreateUser(u *User) (*User, error) {
+func (c *NullClient) CreateUser(ctx context.Context, u *User) (*User, error) {
 	req := &createUserRequest{
 		Email:          u.Email,
 		FirstName:      u.FirstName,
@@ -61,25 +51,17 @@ func (c *NullClient) CreateUser(u *User) (*User, error) {
 		return nil, fmt.Errorf("failed to encode user: %v", err)
 	}
 
-	res, err := c.MakeRequest("POST", USER_INVITE_PATH, &buf)
+	res, err := c.MakeRequest(ctx, "POST", USER_INVITE_PATH, &buf)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		apiError := &ApiError{}
-		if err := json.NewDecoder(res.Body).Decode(apiError); err != nil {
-			bodyBytes, _ := io.ReadAll(res.Body)
-			return nil, fmt.Errorf("failed to create user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-		}
-
-		if apiError.Message == "The user already exists" {
-			return nil, &ResourceExistsError{"user", apiError.ID, apiError.Message}
+	if err := checkResponse(res, "user", "create"); err != nil {
+		if apiErr, ok := AsAPIError(err); ok && apiErr.Message == "The user already exists" {
+			return nil, &ResourceExistsError{"user", apiErr.ID, apiErr.Message}
 		}
-
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to create user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+		return nil, err
 	}
 
 	user := &User{}
@@ -90,18 +72,17 @@ func (c *NullClient) CreateUser(u *User) (*User, error) {
 	return user, nil
 }
 
-func (c *NullClient) GetUser(userID string) (*User, error) {
+func (c *NullClient) GetUser(ctx context.Context, userID string) (*User, error) {
 	path := fmt.Sprintf("%s/%s", USER_PATH, userID)
 
-	res, err := c.MakeRequest("GET", path, nil)
+	res, err := c.MakeRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to get user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "user", "get"); err != nil {
+		return nil, err
 	}
 
 	user := &User{}
@@ -112,7 +93,7 @@ func (c *NullClient) GetUser(userID string) (*User, error) {
 	return user, nil
 }
 
-func (c *NullClient) UpdateUser(userID string, u *User) error {
+func (c *NullClient) UpdateUser(ctx context.Context, userID string, u *User) error {
 	path := fmt.Sprintf("%s/%s", USER_PATH, userID)
 
 	var buf bytes.Buffer
@@ -121,21 +102,16 @@ func (c *NullClient) UpdateUser(userID string, u *User) error {
 		return fmt.Errorf("failed to encode user: %v", err)
 	}
 
-	res, err := c.MakeRequest("PATCH", path, &buf)
+	res, err := c.MakeRequest(ctx, "PATCH", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to update user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	return nil
+	return checkResponse(res, "user", "update")
 }
 
-func (c *NullClient) DeleteUser(userID string) error {
+func (c *NullClient) DeleteUser(ctx context.Context, userID string) error {
 	path := fmt.Sprintf("%s/%s", USER_PATH, userID)
 
 	deactivateReq := &deactivateUserRequest{
@@ -148,45 +124,32 @@ func (c *NullClient) DeleteUser(userID string) error {
 		return fmt.Errorf("failed to encode deactivate request: %v", err)
 	}
 
-	res, err := c.MakeRequest("PUT", path, &buf)
+	res, err := c.MakeRequest(ctx, "PUT", path, &buf)
 	if err != nil {
 		return fmt.Errorf("failed to make API request: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("failed to deactivate user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
+	if err := checkResponse(res, "user", "delete"); err != nil {
+		if _, ok := IsResourceNotFoundError(err); !ok {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func (c *NullClient) LookupUser(userValues *User) (*User, error) {
-	path := fmt.Sprintf("%s?organization_id=%d&email=%s", USER_PATH, userValues.OrganizationID, userValues.Email)
+func (c *NullClient) LookupUser(ctx context.Context, userValues *User) (*User, error) {
+	path := fmt.Sprintf("%s?organization_id=%d&email=%s", USER_PATH, userValues.OrganizationID, url.QueryEscape(userValues.Email))
 
-	res, err := c.MakeRequest("GET", path, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make API request: %v", err)
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("failed to lookup user: status code %d, response: %s", res.StatusCode, string(bodyBytes))
-	}
-
-	listResult := &UserList{}
-	if err := json.NewDecoder(res.Body).Decode(listResult); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %v", err)
-	}
-
-	users := listResult.Results
-	if len(users) == 0 {
-		return nil, &ResourceNotFoundError{ApiType: "user", ID: 0, Message: "user not found"}
+	// The email filter matches at most one user, the first result is all that
+	// is needed.
+	for user, err := range paginate[User](ctx, c, path, "users") {
+		if err != nil {
+			return nil, err
+		}
+		return &user, nil
 	}
 
-	user := (users)[0]
-
-	return &user, nil
+	return nil, &ResourceNotFoundError{ApiType: "user", ID: 0, Message: "user not found"}
 }
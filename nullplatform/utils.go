@@ -91,6 +91,14 @@ func mapOfInterfacesToMapOfStrings(m map[string]interface{}) map[string]string {
 	return out
 }
 
+func mapOfStringsToMapOfInterfaces(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
 func valueToString(v interface{}) string {
 	switch val := v.(type) {
 	case string: